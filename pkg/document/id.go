@@ -0,0 +1,30 @@
+package document
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	nonIDChars  = regexp.MustCompile(`[^a-z0-9]+`)
+	nonIDAtEnds = regexp.MustCompile(`^[-0-9]+|-+$`)
+)
+
+// MakeID converts s into an identifier suitable for use as an element id or class name. The conversion is the same as
+// docutils' nodes.make_id: the text is lowercased, accented characters are decomposed and the non-ASCII parts dropped, and
+// runs of characters other than letters and digits are replaced with a single hyphen. Leading digits and hyphens, and
+// trailing hyphens are removed.
+func MakeID(s string) string {
+	id := strings.ToLower(s)
+	id = strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII {
+			return -1
+		}
+		return r
+	}, norm.NFKD.String(id))
+	id = nonIDChars.ReplaceAllString(strings.Join(strings.Fields(id), " "), "-")
+	return nonIDAtEnds.ReplaceAllString(id, "")
+}
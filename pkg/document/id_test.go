@@ -0,0 +1,21 @@
+package document
+
+import "testing"
+
+func TestMakeID(t *testing.T) {
+	tests := []struct {
+		input, expect string
+	}{
+		{"Note", "note"},
+		{"And, by the way...", "and-by-the-way"},
+		{"  Leading and   trailing  ", "leading-and-trailing"},
+		{"1. Numbered title", "numbered-title"},
+		{"Café crème", "cafe-creme"},
+		{"---", ""},
+	}
+	for _, tt := range tests {
+		if got := MakeID(tt.input); got != tt.expect {
+			t.Errorf("MakeID(%q) = %q, expect %q", tt.input, got, tt.expect)
+		}
+	}
+}
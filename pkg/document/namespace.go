@@ -0,0 +1,151 @@
+package document
+
+import "strings"
+
+// NormalizeReferenceName returns the normalized form of the reference name s. Runs of white space are collapsed to a single
+// space, leading and trailing white space is removed, and the name is lowercased. Two reference names refer to the same
+// element if their normalized forms are equal. This is docutils' nodes.fully_normalize_name.
+func NormalizeReferenceName(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// NameKind is the kind of element defining a reference name.
+type NameKind int
+
+const (
+	// NameTarget is a name defined by a hyperlink target. Explicit targets, elements named with the name option of a
+	// directive, section titles and other implicit targets are hyperlink targets.
+	NameTarget NameKind = iota
+
+	// NameFootnote is the label of a footnote.
+	NameFootnote
+
+	// NameCitation is the label of a citation.
+	NameCitation
+
+	// NameSubstitution is the name of a substitution definition.
+	NameSubstitution
+)
+
+var nameKinds = [...]string{"target", "footnote", "citation", "substitution"}
+
+// String implements Stringer and returns the NameKind as a string.
+func (k NameKind) String() string { return nameKinds[k] }
+
+// MarshalText implements encoding.TextMarshaler so the NameKind is encoded as a string.
+func (k NameKind) MarshalText() ([]byte, error) { return []byte(k.String()), nil }
+
+// Name is the definition of a reference name in a document.
+type Name struct {
+	Name          string   `json:"name"`                    // The normalized reference name
+	Kind          NameKind `json:"kind"`                    // The kind of element defining the name
+	Explicit      bool     `json:"explicit"`                // False if defined by an implicit target, such as a section title
+	ID            string   `json:"id,omitempty"`            // The id of the element defining the name
+	Node          Node     `json:"-"`                       // The element defining the name
+	Line          int      `json:"line,omitempty"`          // The line of the definition
+	StartPosition int      `json:"startPosition,omitempty"` // The start position of the definition
+}
+
+// Namespace contains the reference names defined in a document. Hyperlink targets, footnote labels and citation labels
+// share the namespace of hyperlink references, substitution names have a namespace of their own. Two definitions of a name
+// in the same namespace are duplicates if they are of the same kind, otherwise they conflict.
+type Namespace struct {
+	names []*Name            // Every definition in the order they were added
+	refs  map[string][]*Name // The definitions of each hyperlink reference name
+	subs  map[string][]*Name // The definitions of each substitution name
+}
+
+// NewNamespace returns an empty Namespace.
+func NewNamespace() *Namespace {
+	return &Namespace{refs: make(map[string][]*Name), subs: make(map[string][]*Name)}
+}
+
+// space returns the definitions of the namespace containing names of kind.
+func (ns *Namespace) space(kind NameKind) map[string][]*Name {
+	if kind == NameSubstitution {
+		return ns.subs
+	}
+	return ns.refs
+}
+
+// Add adds the definition n to the namespace, the name of n is normalized with NormalizeReferenceName. The earlier
+// definitions of the name in the same namespace are returned, split into the duplicates of the same kind as n and the
+// conflicting definitions of other kinds.
+func (ns *Namespace) Add(n *Name) (duplicates, conflicts []*Name) {
+	n.Name = NormalizeReferenceName(n.Name)
+	s := ns.space(n.Kind)
+	for _, d := range s[n.Name] {
+		if d.Kind == n.Kind {
+			duplicates = append(duplicates, d)
+		} else {
+			conflicts = append(conflicts, d)
+		}
+	}
+	s[n.Name] = append(s[n.Name], n)
+	ns.names = append(ns.names, n)
+	return
+}
+
+// Lookup returns the definitions of name in the namespace containing names of kind, in the order they were added. name is
+// normalized with NormalizeReferenceName before the lookup.
+func (ns *Namespace) Lookup(kind NameKind, name string) []*Name {
+	return ns.space(kind)[NormalizeReferenceName(name)]
+}
+
+// Names returns the definitions in the namespace in the order they were added. If kinds are given, only the definitions of
+// those kinds are returned.
+func (ns *Namespace) Names(kinds ...NameKind) []*Name {
+	var out []*Name
+	for _, n := range ns.names {
+		if len(kinds) == 0 || hasKind(kinds, n.Kind) {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// Duplicates returns the definitions of every name defined more than once by elements of the same kind. The definitions
+// of each name are in the order they were added.
+func (ns *Namespace) Duplicates() [][]*Name {
+	return ns.find(func(a, b *Name) bool { return a.Kind == b.Kind })
+}
+
+// Conflicts returns the definitions of every name defined by elements of different kinds in the same namespace. The
+// definitions of each name are in the order they were added.
+func (ns *Namespace) Conflicts() [][]*Name {
+	return ns.find(func(a, b *Name) bool { return a.Kind != b.Kind })
+}
+
+// find returns the definitions of every name that has two definitions a and b for which match returns true. The names are
+// in the order of their first definition.
+func (ns *Namespace) find(match func(a, b *Name) bool) [][]*Name {
+	var out [][]*Name
+	seen := make(map[*Name]bool)
+	for _, n := range ns.names {
+		defs := ns.space(n.Kind)[n.Name]
+		if seen[defs[0]] {
+			continue
+		}
+		seen[defs[0]] = true
+	outer:
+		for i, a := range defs {
+			for _, b := range defs[i+1:] {
+				if match(a, b) {
+					out = append(out, defs)
+					break outer
+				}
+			}
+		}
+	}
+	return out
+}
+
+// hasKind returns true if kinds contains kind.
+func hasKind(kinds []NameKind, kind NameKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package document
+
+import "testing"
+
+func TestNormalizeReferenceName(t *testing.T) {
+	tests := []struct {
+		input, expect string
+	}{
+		{"Note", "note"},
+		{"  A  Phrase\n  Reference ", "a phrase reference"},
+		{"Tab\tSeparated", "tab separated"},
+		{"ÉCOLE", "école"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeReferenceName(tt.input); got != tt.expect {
+			t.Errorf("NormalizeReferenceName(%q) = %q, expect %q", tt.input, got, tt.expect)
+		}
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	ns := NewNamespace()
+	adds := []struct {
+		name       *Name
+		duplicates int
+		conflicts  int
+	}{
+		{&Name{Name: "Title", Kind: NameTarget}, 0, 0},
+		{&Name{Name: "title", Kind: NameTarget, Explicit: true}, 1, 0},
+		{&Name{Name: "A  Label", Kind: NameCitation}, 0, 0},
+		{&Name{Name: "a label", Kind: NameTarget}, 0, 1},
+		{&Name{Name: "TITLE", Kind: NameSubstitution}, 0, 0},
+		{&Name{Name: "other", Kind: NameFootnote}, 0, 0},
+	}
+	for _, tt := range adds {
+		d, c := ns.Add(tt.name)
+		if len(d) != tt.duplicates || len(c) != tt.conflicts {
+			t.Errorf("Add(%q): got %d duplicates and %d conflicts, expect %d and %d", tt.name.Name, len(d), len(c),
+				tt.duplicates, tt.conflicts)
+		}
+	}
+	if got := len(ns.Lookup(NameTarget, " Title ")); got != 2 {
+		t.Errorf("got %d target definitions of \"title\", expect 2", got)
+	}
+	if got := len(ns.Lookup(NameSubstitution, "title")); got != 1 {
+		t.Errorf("got %d substitution definitions of \"title\", expect 1", got)
+	}
+	if got := len(ns.Names()); got != len(adds) {
+		t.Errorf("got %d names, expect %d", got, len(adds))
+	}
+	if got := ns.Names(NameCitation, NameFootnote); len(got) != 2 || got[0].Name != "a label" || got[1].Name != "other" {
+		t.Errorf("got citation and footnote names %v, expect \"a label\" and \"other\"", got)
+	}
+	if d := ns.Duplicates(); len(d) != 1 || d[0][0].Name != "title" || len(d[0]) != 2 {
+		t.Errorf("got duplicates %v, expect the two definitions of \"title\"", d)
+	}
+	if c := ns.Conflicts(); len(c) != 1 || c[0][0].Name != "a label" || len(c[0]) != 2 {
+		t.Errorf("got conflicts %v, expect the two definitions of \"a label\"", c)
+	}
+	if s := NameCitation.String(); s != "citation" {
+		t.Errorf("got NameCitation.String() == %q, expect \"citation\"", s)
+	}
+}
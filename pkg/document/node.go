@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/demizer/go-rst/pkg/messages"
 	tok "github.com/demizer/go-rst/pkg/token"
@@ -28,6 +30,9 @@ const (
 	// NodeBlockQuote is a blockquote element.
 	NodeBlockQuote
 
+	// NodeAttribution is the attribution of a blockquote.
+	NodeAttribution
+
 	// NodeSystemMessage contains an error encountered by the parser.
 	NodeSystemMessage
 
@@ -68,6 +73,9 @@ const (
 	// NodeDefinition is a definition element
 	NodeDefinition
 
+	// NodeClassifier is a classifier of a definition list term
+	NodeClassifier
+
 	// NodeInlineEmphasis is the italicized text element
 	NodeInlineEmphasis
 
@@ -82,6 +90,87 @@ const (
 
 	// NodeInlineInterpretedTextRole is the role of the interpreted text
 	NodeInlineInterpretedTextRole
+
+	// NodeAdmonition is an admonition element created by an admonition directive, i.e., note or warning.
+	NodeAdmonition
+
+	// NodeInline is a generic inline element with classes, such as a token of highlighted code.
+	NodeInline
+
+	// NodeImage is an image created by the image directive.
+	NodeImage
+
+	// NodeFigure is an image with a caption and legend created by the figure directive.
+	NodeFigure
+
+	// NodeCaption is the caption of a figure.
+	NodeCaption
+
+	// NodeLegend is the legend of a figure.
+	NodeLegend
+
+	// NodeTopic is a titled block of body elements outside of the section structure, such as a table of contents.
+	NodeTopic
+
+	// NodeReference is an internal reference to an element id, such as a table of contents entry.
+	NodeReference
+
+	// NodeGenerated is inline text added by a transform, such as a section number.
+	NodeGenerated
+
+	// NodeRaw is content passed unchanged to renderers of the matching output format.
+	NodeRaw
+
+	// NodeMath is inline LaTeX math created by the math role.
+	NodeMath
+
+	// NodeMathBlock is a block of LaTeX math created by the math directive.
+	NodeMathBlock
+
+	// NodeTable is a table. It contains an optional NodeTableHead followed by a NodeTableBody.
+	NodeTable
+
+	// NodeTableHead contains the header rows of a table.
+	NodeTableHead
+
+	// NodeTableBody contains the body rows of a table.
+	NodeTableBody
+
+	// NodeTableRow is a row of a table.
+	NodeTableRow
+
+	// NodeTableEntry is a cell of a table row containing body elements.
+	NodeTableEntry
+
+	// NodeSubscript is inline subscript text created by the subscript role.
+	NodeSubscript
+
+	// NodeSuperscript is inline superscript text created by the superscript role.
+	NodeSuperscript
+
+	// NodeTitleReference is the title of a book or other work created by the title-reference role.
+	NodeTitleReference
+
+	// NodeAbbreviation is an abbreviation created by the abbreviation role.
+	NodeAbbreviation
+
+	// NodePending is a placeholder for a change made by a transform when parsing is complete.
+	NodePending
+
+	// NodeMeta is HTML metadata created by the meta directive.
+	NodeMeta
+
+	// NodeDocumentTitle is the title metadata of the document set by the title directive.
+	NodeDocumentTitle
+
+	// NodeHyperlinkTarget is an explicit hyperlink target.
+	NodeHyperlinkTarget
+
+	// NodeDocument is the root element of a document.
+	NodeDocument
+
+	// NodeEnumListItem is an item of an enumerated list.
+	NodeEnumListItem
 )
 
 var nodeTypes = [...]string{
@@ -90,6 +179,7 @@ var nodeTypes = [...]string{
 	"NodeParagraph",
 	"NodeAdornment",
 	"NodeBlockQuote",
+	"NodeAttribution",
 	"NodeSystemMessage",
 	"NodeSystemMessages",
 	"NodeLiteralBlock",
@@ -103,11 +193,39 @@ var nodeTypes = [...]string{
 	"NodeDefinitionListItem",
 	"NodeDefinitionTerm",
 	"NodeDefinition",
+	"NodeClassifier",
 	"NodeInlineEmphasis",
 	"NodeInlineStrong",
 	"NodeInlineLiteral",
 	"NodeInlineInterpretedText",
 	"NodeInlineInterpretedTextRole",
+	"NodeAdmonition",
+	"NodeInline",
+	"NodeImage",
+	"NodeFigure",
+	"NodeCaption",
+	"NodeLegend",
+	"NodeTopic",
+	"NodeReference",
+	"NodeGenerated",
+	"NodeRaw",
+	"NodeMath",
+	"NodeMathBlock",
+	"NodeTable",
+	"NodeTableHead",
+	"NodeTableBody",
+	"NodeTableRow",
+	"NodeTableEntry",
+	"NodeSubscript",
+	"NodeSuperscript",
+	"NodeTitleReference",
+	"NodeAbbreviation",
+	"NodePending",
+	"NodeMeta",
+	"NodeDocumentTitle",
+	"NodeHyperlinkTarget",
+	"NodeDocument",
+	"NodeEnumListItem",
 }
 
 // Type returns the type of a node element.
@@ -176,8 +294,19 @@ type SectionNode struct {
 	OverLine  *AdornmentNode `json:"overLine"`
 	UnderLine *AdornmentNode `json:"underLine"`
 
+	// IDs and Names are the identifiers and reference names of the section. Every section is an implicit hyperlink target
+	// named by its title. If another target has the same name, the name is moved to DupNames.
+	IDs      []string `json:"ids,omitempty"`
+	Names    []string `json:"names,omitempty"`
+	DupNames []string `json:"dupnames,omitempty"`
+
+	// Classes are the class names of the section set by the class directive.
+	Classes []string `json:"classes,omitempty"`
+
 	// NodeList contains
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NodeType returns the Node type of the SectionNode.
@@ -205,6 +334,7 @@ func NewSection(title *TitleNode, overSec *tok.Item, underSec *tok.Item, indent
 			StartPosition: overSec.StartPosition,
 			Line:          overSec.Line,
 			Length:        overSec.Length,
+			Span:          NewSpan(overSec),
 		}
 	}
 	Rune := rune(underSec.Text[0])
@@ -214,6 +344,7 @@ func NewSection(title *TitleNode, overSec *tok.Item, underSec *tok.Item, indent
 		StartPosition: underSec.StartPosition,
 		Line:          underSec.Line,
 		Length:        underSec.Length,
+		Span:          NewSpan(underSec),
 	}
 
 	return n
@@ -243,6 +374,20 @@ func (s SectionNode) MarshalJSON() ([]byte, error) {
 	}
 	buffer.WriteString(fmt.Sprintf("\"underLine\": %s,", string(u)))
 
+	for _, f := range []struct {
+		name string
+		list []string
+	}{{"ids", s.IDs}, {"names", s.Names}, {"dupnames", s.DupNames}, {"classes", s.Classes}} {
+		if len(f.list) == 0 {
+			continue
+		}
+		l, err := json.Marshal(f.list)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("%q: %s,", f.name, string(l)))
+	}
+
 	b, err := json.Marshal(s.NodeList)
 	if err != nil {
 		return nil, err
@@ -264,7 +409,12 @@ type TitleNode struct {
 	Length        int               `json:"length"`
 	Line          int               `json:"line,omitempty"`
 	StartPosition int               `json:"startPosition,omitempty"`
+	RefID         string            `json:"refid,omitempty"` // RefID is the id of the element the title links back to
+	IDs           []string          `json:"ids,omitempty"`   // IDs of a subtitle promoted from a section
+	Names         []string          `json:"names,omitempty"` // Names of a subtitle promoted from a section
 	NodeList      `json:"nodeList"` // NodeList contains children of the ParagraphNode, even other ParagraphNodes!
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NodeType returns the Node type of the TitleNode.
@@ -283,6 +433,22 @@ func (t TitleNode) MarshalJSON() ([]byte, error) {
 	buffer.WriteString(fmt.Sprintf("\"length\": %d,", t.Length))
 	buffer.WriteString(fmt.Sprintf("\"line\": %d,", t.Line))
 	buffer.WriteString(fmt.Sprintf("\"startPosition\": %d,", t.StartPosition))
+	if t.RefID != "" {
+		buffer.WriteString(fmt.Sprintf("\"refid\": %q,", t.RefID))
+	}
+	for _, f := range []struct {
+		name string
+		list []string
+	}{{"ids", t.IDs}, {"names", t.Names}} {
+		if len(f.list) == 0 {
+			continue
+		}
+		l, err := json.Marshal(f.list)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("%q: %s,", f.name, string(l)))
+	}
 
 	b, err := json.Marshal(t.NodeList)
 	if err != nil {
@@ -304,6 +470,7 @@ func NewTitleNodeWithText(i *tok.Item) *TitleNode {
 		Type:          NodeTitle,
 		Length:        i.Length,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 		Line:          i.Line,
 	}
 	tn.Append(NewText(i))
@@ -317,6 +484,8 @@ type AdornmentNode struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NodeType returns the Node type of the AdornmentNode.
@@ -349,6 +518,8 @@ type TextNode struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewText(i *tok.Item) *TextNode {
@@ -358,6 +529,7 @@ func NewText(i *tok.Item) *TextNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -387,7 +559,10 @@ func (t TextNode) MarshalJSON() ([]byte, error) {
 // ParagraphNode is a parsed paragraph.
 type ParagraphNode struct {
 	Type     NodeType          `json:"type"`
+	Classes  []string          `json:"classes,omitempty"`
 	NodeList `json:"nodeList"` // NodeList contains children of the ParagraphNode, even other ParagraphNodes!
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewParagraph() *ParagraphNode { return &ParagraphNode{Type: NodeParagraph} }
@@ -408,6 +583,13 @@ func (p ParagraphNode) String() string { return fmt.Sprintf("%#v", p) }
 func (p ParagraphNode) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString("{")
 	buffer.WriteString(fmt.Sprintf("\"type\": %q,", p.Type.String()))
+	if len(p.Classes) > 0 {
+		c, err := json.Marshal(p.Classes)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"classes\": %s,", string(c)))
+	}
 	b, err := json.Marshal(p.NodeList)
 	if err != nil {
 		return nil, err
@@ -427,6 +609,9 @@ type InlineEmphasisNode struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewInlineEmphasis(i *tok.Item) *InlineEmphasisNode {
@@ -436,6 +621,7 @@ func NewInlineEmphasis(i *tok.Item) *InlineEmphasisNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -448,17 +634,19 @@ func (e InlineEmphasisNode) String() string { return fmt.Sprintf("%#v", e) }
 // MarshalJSON satisfies the Marshaler interface.
 func (e InlineEmphasisNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		Classes       []string `json:"classes,omitempty"`
 	}{
 		Type:          nodeTypes[e.Type],
 		Text:          e.Text,
 		Length:        e.Length,
 		Line:          e.Line,
 		StartPosition: e.StartPosition,
+		Classes:       e.Classes,
 	})
 }
 
@@ -469,6 +657,9 @@ type InlineStrongNode struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewInlineStrong(i *tok.Item) *InlineStrongNode {
@@ -478,6 +669,7 @@ func NewInlineStrong(i *tok.Item) *InlineStrongNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -490,27 +682,34 @@ func (s InlineStrongNode) String() string { return fmt.Sprintf("%#v", s) }
 // MarshalJSON satisfies the Marshaler interface.
 func (s InlineStrongNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		Classes       []string `json:"classes,omitempty"`
 	}{
 		Type:          nodeTypes[s.Type],
 		Text:          s.Text,
 		Length:        s.Length,
 		Line:          s.Line,
 		StartPosition: s.StartPosition,
+		Classes:       s.Classes,
 	})
 }
 
-// InlineLiteralNode is a parsed inline literal node.
+// InlineLiteralNode is a parsed inline literal node. Inline literals created by the code role have the "code" class and
+// contain the highlighted code as InlineNodes in NodeList.
 type InlineLiteralNode struct {
 	Type          NodeType `json:"type"`
 	Text          string   `json:"text"`
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+	NodeList      `json:"nodeList,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewInlineLiteral(i *tok.Item) *InlineLiteralNode {
@@ -520,6 +719,7 @@ func NewInlineLiteral(i *tok.Item) *InlineLiteralNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -532,17 +732,62 @@ func (l InlineLiteralNode) String() string { return fmt.Sprintf("%#v", l) }
 // MarshalJSON satisfies the Marshaler interface.
 func (l InlineLiteralNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		Classes       []string `json:"classes,omitempty"`
+		NodeList      NodeList `json:"nodeList,omitempty"`
 	}{
 		Type:          nodeTypes[l.Type],
 		Text:          l.Text,
 		Length:        l.Length,
 		Line:          l.Line,
 		StartPosition: l.StartPosition,
+		Classes:       l.Classes,
+		NodeList:      l.NodeList,
+	})
+}
+
+// InlineNode is a generic inline element. The meaning of the text is given by the classes of the node.
+type InlineNode struct {
+	Type    NodeType `json:"type"`
+	Text    string   `json:"text"`
+	Length  int      `json:"length"`
+	Classes []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewInline returns an InlineNode containing text with the classes.
+func NewInline(text string, classes ...string) *InlineNode {
+	return &InlineNode{
+		Type:    NodeInline,
+		Text:    text,
+		Length:  utf8.RuneCountInString(text),
+		Classes: classes,
+	}
+}
+
+// NodeType returns the Node type of the InlineNode.
+func (i InlineNode) NodeType() NodeType { return i.Type }
+
+// String satisfies the Stringer interface
+func (i InlineNode) String() string { return fmt.Sprintf("%#v", i) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (i InlineNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type    string   `json:"type"`
+		Text    string   `json:"text"`
+		Length  int      `json:"length"`
+		Classes []string `json:"classes,omitempty"`
+	}{
+		Type:    nodeTypes[i.Type],
+		Text:    i.Text,
+		Length:  i.Length,
+		Classes: i.Classes,
 	})
 }
 
@@ -555,6 +800,8 @@ type InlineInterpretedText struct {
 	StartPosition int      `json:"startPosition,omitempty"`
 	// NodeList contains Nodes parsed as children of the BlockQuoteNode.
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewInlineInterpretedText(i *tok.Item) *InlineInterpretedText {
@@ -564,6 +811,7 @@ func NewInlineInterpretedText(i *tok.Item) *InlineInterpretedText {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -600,6 +848,8 @@ type InlineInterpretedTextRole struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewInlineInterpretedTextRole(i *tok.Item) *InlineInterpretedTextRole {
@@ -609,6 +859,7 @@ func NewInlineInterpretedTextRole(i *tok.Item) *InlineInterpretedTextRole {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -640,27 +891,21 @@ type BlockQuoteNode struct {
 	Type          NodeType `json:"type"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
 	// NodeList contains Nodes parsed as children of the BlockQuoteNode.
 	NodeList `json:"nodeList"`
-}
 
-func NewEmptyBlockQuote(i *tok.Item) *BlockQuoteNode {
-	bq := &BlockQuoteNode{
-		Type:          NodeBlockQuote,
-		Line:          i.Line,
-		StartPosition: i.StartPosition,
-	}
-	return bq
+	Span Span `json:"-"` // The location of the node in the input
 }
 
+// NewBlockQuote initializes a new BlockQuoteNode at the line and start position of i.
 func NewBlockQuote(i *tok.Item) *BlockQuoteNode {
-	bq := &BlockQuoteNode{
+	return &BlockQuoteNode{
 		Type:          NodeBlockQuote,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
-	bq.NodeList.Append(NewParagraphWithNodeText(i))
-	return bq
 }
 
 // NodeType returns the Node type of the BlockQuoteNode.
@@ -675,6 +920,13 @@ func (b BlockQuoteNode) MarshalJSON() ([]byte, error) {
 	buffer.WriteString(fmt.Sprintf("\"type\": %q,", b.Type.String()))
 	buffer.WriteString(fmt.Sprintf("\"line\": %d,", b.Line))
 	buffer.WriteString(fmt.Sprintf("\"startPosition\": %d,", b.StartPosition))
+	if len(b.Classes) > 0 {
+		c, err := json.Marshal(b.Classes)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"classes\": %s,", string(c)))
+	}
 	n, err := json.Marshal(b.NodeList)
 	if err != nil {
 		return nil, err
@@ -687,10 +939,66 @@ func (b BlockQuoteNode) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// AttributionNode is the attribution of a block quote. Text is the source text of the attribution without the leading dash,
+// NodeList contains the parsed inline markup of the attribution.
+type AttributionNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	NodeList      `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewAttribution initializes a new AttributionNode with the text and position of i.
+func NewAttribution(i *tok.Item) *AttributionNode {
+	return &AttributionNode{
+		Type:          NodeAttribution,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the AttributionNode.
+func (a AttributionNode) NodeType() NodeType { return a.Type }
+
+// String satisfies the Stringer interface
+func (a AttributionNode) String() string { return fmt.Sprintf("%#v", a) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (a AttributionNode) MarshalJSON() ([]byte, error) {
+	nl := a.NodeList
+	if nl == nil {
+		nl = NodeList{}
+	}
+	return json.Marshal(&struct {
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		NodeList      NodeList `json:"nodeList"`
+	}{
+		Type:          nodeTypes[a.Type],
+		Text:          a.Text,
+		Length:        a.Length,
+		Line:          a.Line,
+		StartPosition: a.StartPosition,
+		NodeList:      nl,
+	})
+}
+
 // SystemMessages contains system messages if present
 type SystemMessagesNode struct {
 	Type     NodeType          `json:"type"`
 	NodeList `json:"nodeList"` // NodeList contains a list of system messages generated while parsing
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewSystemMessagesNode() *SystemMessagesNode { return &SystemMessagesNode{Type: NodeSystemMessages} }
@@ -734,6 +1042,9 @@ type SystemMessageNode struct {
 	// The character position of the start of the problem that resulted in a system message
 	StartPosition int `json:"StartPosition,omitempty"`
 
+	// Source is the name of the input containing the problem if it is not the main document, i.e., an included file.
+	Source string `json:"source,omitempty"`
+
 	// The type of parser message that generated the systemMessage.
 	MessageType string `json:"messageType"`
 
@@ -744,6 +1055,8 @@ type SystemMessageNode struct {
 	// which contains the message, and a NodeLiteralBlock which contains the input data causing the systemMessage to be
 	// generated.
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewSystemMessage(pm *messages.ParserMessage, line int) *SystemMessageNode {
@@ -784,6 +1097,9 @@ func (s SystemMessageNode) MarshalJSON() ([]byte, error) {
 	if s.StartPosition > 0 {
 		buffer.WriteString(fmt.Sprintf("\"startPosition\": %d,", s.StartPosition))
 	}
+	if s.Source != "" {
+		buffer.WriteString(fmt.Sprintf("\"source\": %q,", s.Source))
+	}
 	b, err := json.Marshal(s.NodeList)
 	if err != nil {
 		return nil, err
@@ -796,13 +1112,24 @@ func (s SystemMessageNode) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-// LiteralBlockNode is a parsed literal block element.
+// LiteralBlockNode is a parsed literal block element. Literal blocks created by the code directive have the "code" class
+// and the language as classes. The highlighted code is contained in NodeList as InlineNodes, with unclassified text as
+// TextNodes. If line numbering is enabled, each line is preceded by an InlineNode with the "ln" class containing the line
+// number.
 type LiteralBlockNode struct {
 	Type          NodeType `json:"type"`
 	Text          string   `json:"text"`
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Language      string   `json:"language,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+	IDs           []string `json:"ids,omitempty"`
+	Names         []string `json:"names,omitempty"`
+	DupNames      []string `json:"dupnames,omitempty"`
+	NodeList      `json:"nodeList,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewLiteralBlock(i *tok.Item) *LiteralBlockNode {
@@ -812,6 +1139,7 @@ func NewLiteralBlock(i *tok.Item) *LiteralBlockNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -824,17 +1152,29 @@ func (l LiteralBlockNode) String() string { return fmt.Sprintf("%#v", l) }
 // MarshalJSON satisfies the Marshaler interface.
 func (l LiteralBlockNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		Language      string   `json:"language,omitempty"`
+		Classes       []string `json:"classes,omitempty"`
+		IDs           []string `json:"ids,omitempty"`
+		Names         []string `json:"names,omitempty"`
+		DupNames      []string `json:"dupnames,omitempty"`
+		NodeList      NodeList `json:"nodeList,omitempty"`
 	}{
 		Type:          nodeTypes[l.Type],
 		Text:          l.Text,
 		Length:        l.Length,
 		Line:          l.Line,
 		StartPosition: l.StartPosition,
+		Language:      l.Language,
+		Classes:       l.Classes,
+		IDs:           l.IDs,
+		Names:         l.Names,
+		DupNames:      l.DupNames,
+		NodeList:      l.NodeList,
 	})
 }
 
@@ -845,6 +1185,9 @@ type TransitionNode struct {
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewTransition(i *tok.Item) *TransitionNode {
@@ -854,6 +1197,7 @@ func NewTransition(i *tok.Item) *TransitionNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -866,17 +1210,19 @@ func (t TransitionNode) String() string { return fmt.Sprintf("%#v", t) }
 // MarshalJSON satisfies the Marshaler interface.
 func (t TransitionNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		Classes       []string `json:"classes,omitempty"`
 	}{
 		Type:          nodeTypes[t.Type],
 		Text:          t.Text,
 		Length:        t.Length,
 		Line:          t.Line,
 		StartPosition: t.StartPosition,
+		Classes:       t.Classes,
 	})
 }
 
@@ -887,6 +1233,8 @@ type CommentNode struct {
 	Length        int      `json:"length,omitempty"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewComment(i *tok.Item) *CommentNode {
@@ -896,6 +1244,7 @@ func NewComment(i *tok.Item) *CommentNode {
 		Length:        i.Length,
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
 	}
 }
 
@@ -926,7 +1275,10 @@ func (c CommentNode) MarshalJSON() ([]byte, error) {
 type BulletListNode struct {
 	Type     NodeType `json:"type"`
 	Bullet   string   `json:"bullet"`
+	Classes  []string `json:"classes,omitempty"`
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NewEnumListNode initializes a new BulletListNode.
@@ -934,6 +1286,7 @@ func NewBulletListNode(i *tok.Item) *BulletListNode {
 	return &BulletListNode{
 		Type:   NodeBulletList,
 		Bullet: i.Text,
+		Span:   NewSpan(i),
 	}
 }
 
@@ -948,6 +1301,13 @@ func (b BulletListNode) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString("{")
 	buffer.WriteString(fmt.Sprintf("\"type\": %q,", b.Type.String()))
 	buffer.WriteString(fmt.Sprintf("\"bullet\": %q,", b.Bullet))
+	if len(b.Classes) > 0 {
+		c, err := json.Marshal(b.Classes)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"classes\": %s,", string(c)))
+	}
 	n, err := json.Marshal(b.NodeList)
 	if err != nil {
 		return nil, err
@@ -964,11 +1324,13 @@ func (b BulletListNode) MarshalJSON() ([]byte, error) {
 type BulletListItemNode struct {
 	Type     NodeType `json:"type"`
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NewBulletListNode initializes a new EnumListNode.
 func NewBulletListItemNode(i *tok.Item) *BulletListItemNode {
-	return &BulletListItemNode{Type: NodeBulletListItem}
+	return &BulletListItemNode{Type: NodeBulletListItem, Span: NewSpan(i)}
 }
 
 // NodeType returns the type of Node for the bullet list item.
@@ -993,36 +1355,41 @@ func (b BulletListItemNode) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-// EnumListNode defines an enumerated list element.
+// EnumListNode defines an enumerated list element. Start is the ordinal of the first item of the list.
 type EnumListNode struct {
 	Type     NodeType      `json:"type"`
 	EnumType EnumListType  `json:"enumType"`
 	Affix    EnumAffixType `json:"affix"`
+	Start    int           `json:"start"`
+	Classes  []string      `json:"classes,omitempty"`
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
-// NewEnumListNode initializes a new EnumListNode.
-func NewEnumListNode(enumList *tok.Item, affix *tok.Item) *EnumListNode {
-	var enType EnumListType
-	switch enumList.Type {
-	case tok.EnumListArabic:
-		enType = enumListArabic
-	}
+// enumListSequences maps the enumeration sequences of enumerators to enumerated list types.
+var enumListSequences = map[string]EnumListType{
+	"arabic":     enumListArabic,
+	"loweralpha": enumListLowerAlpha,
+	"upperalpha": enumListUpperAlpha,
+	"lowerroman": enumListLowerRoman,
+	"upperroman": enumListUpperRoman,
+	"#":          enumListAuto,
+}
 
-	var afType EnumAffixType
-	switch affix.Text {
-	case ".":
-		afType = enumAffixPeriod
-		// case "(":
-		// afType = enumAffixParenthesisSurround
-		// case ")":
-		// afType = enumAffixParenthesisRight
+// NewEnumListNode initializes a new EnumListNode for a list beginning with the enumerator e.
+func NewEnumListNode(e *tok.Enumerator) *EnumListNode {
+	afType := enumAffixPeriod
+	if e.Prefix == "(" {
+		afType = enumAffixParenthesisSurround
+	} else if e.Suffix == ")" {
+		afType = enumAffixParenthesisRight
 	}
-
 	return &EnumListNode{
 		Type:     NodeEnumList,
-		EnumType: enType,
+		EnumType: enumListSequences[e.Sequence],
 		Affix:    afType,
+		Start:    e.Ordinal,
 	}
 }
 
@@ -1038,6 +1405,14 @@ func (e EnumListNode) MarshalJSON() ([]byte, error) {
 	buffer.WriteString(fmt.Sprintf("\"type\": %q,", e.Type.String()))
 	buffer.WriteString(fmt.Sprintf("\"enumType\": %q,", e.EnumType))
 	buffer.WriteString(fmt.Sprintf("\"affix\": %q,", e.Affix))
+	buffer.WriteString(fmt.Sprintf("\"start\": %d,", e.Start))
+	if len(e.Classes) > 0 {
+		c, err := json.Marshal(e.Classes)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"classes\": %s,", string(c)))
+	}
 	b, err := json.Marshal(e.NodeList)
 	if err != nil {
 		return nil, err
@@ -1050,14 +1425,50 @@ func (e EnumListNode) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// EnumListItemNode defines an enumerated list item element.
+type EnumListItemNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewEnumListItemNode initializes a new EnumListItemNode.
+func NewEnumListItemNode() *EnumListItemNode { return &EnumListItemNode{Type: NodeEnumListItem} }
+
+// NodeType returns the type of Node for the enumerated list item.
+func (e EnumListItemNode) NodeType() NodeType { return e.Type }
+
+// String satisfies the Stringer interface
+func (e EnumListItemNode) String() string { return fmt.Sprintf("%#v", e) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (e EnumListItemNode) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	buffer.WriteString(fmt.Sprintf("\"type\": %q,", e.Type.String()))
+	n, err := json.Marshal(e.NodeList)
+	if err != nil {
+		return nil, err
+	}
+	if string(n) == "null" {
+		n = []byte{'[', ' ', ']'}
+	}
+	buffer.WriteString(fmt.Sprintf("\"nodeList\": %s", string(n)))
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
 // DefinitionListNode defines a definition list element.
 type DefinitionListNode struct {
 	Type     NodeType `json:"type"`
+	Classes  []string `json:"classes,omitempty"`
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 func NewDefinitionList(i *tok.Item) *DefinitionListNode {
-	return &DefinitionListNode{Type: NodeDefinitionList}
+	return &DefinitionListNode{Type: NodeDefinitionList, Span: NewSpan(i)}
 }
 
 // NodeType returns the Node type of DefinitionListNode.
@@ -1070,6 +1481,13 @@ func (d DefinitionListNode) String() string { return fmt.Sprintf("%#v", d) }
 func (d DefinitionListNode) MarshalJSON() ([]byte, error) {
 	buffer := bytes.NewBufferString("{")
 	buffer.WriteString(fmt.Sprintf("\"type\": %q,", d.Type.String()))
+	if len(d.Classes) > 0 {
+		c, err := json.Marshal(d.Classes)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"classes\": %s,", string(c)))
+	}
 	b, err := json.Marshal(d.NodeList)
 	if err != nil {
 		return nil, err
@@ -1084,18 +1502,23 @@ func (d DefinitionListNode) MarshalJSON() ([]byte, error) {
 
 // DefinitionListItemNode defines a definition list item element.
 type DefinitionListItemNode struct {
-	Type       NodeType            `json:"type"`
-	Term       *DefinitionTermNode `json:"term"`
-	Definition *DefinitionNode     `json:"definition"`
+	Type        NodeType            `json:"type"`
+	Term        *DefinitionTermNode `json:"term"`
+	Classifiers []*ClassifierNode   `json:"classifiers,omitempty"`
+	Definition  *DefinitionNode     `json:"definition"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
-func NewDefinitionListItem(defTerm *tok.Item, def *tok.Item) *DefinitionListItemNode {
+// NewDefinitionListItem initializes a new DefinitionListItemNode with the term of defTerm and an empty definition.
+func NewDefinitionListItem(defTerm *tok.Item) *DefinitionListItemNode {
 	n := &DefinitionListItemNode{Type: NodeDefinitionListItem}
 	ndt := &DefinitionTermNode{
 		Type:          NodeDefinitionTerm,
 		Text:          defTerm.Text,
 		Length:        defTerm.Length,
 		StartPosition: defTerm.StartPosition,
+		Span:          NewSpan(defTerm),
 		Line:          defTerm.Line,
 	}
 	nd := &DefinitionNode{Type: NodeDefinition}
@@ -1113,23 +1536,29 @@ func (d DefinitionListItemNode) String() string { return fmt.Sprintf("%#v", d) }
 // MarshalJSON satisfies the Marshaler interface.
 func (d DefinitionListItemNode) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		Type       string              `json:"type"`
-		Term       *DefinitionTermNode `json:"term"`
-		Definition *DefinitionNode     `json:"definition"`
+		Type        string              `json:"type"`
+		Term        *DefinitionTermNode `json:"term"`
+		Classifiers []*ClassifierNode   `json:"classifiers,omitempty"`
+		Definition  *DefinitionNode     `json:"definition"`
 	}{
-		Type:       nodeTypes[d.Type],
-		Term:       d.Term,
-		Definition: d.Definition,
+		Type:        nodeTypes[d.Type],
+		Term:        d.Term,
+		Classifiers: d.Classifiers,
+		Definition:  d.Definition,
 	})
 }
 
-// DefinitionTermNode defines a definition list term element.
+// DefinitionTermNode defines a definition list term element. Text is the source text of the term line, NodeList contains the
+// parsed inline markup of the term without the classifiers.
 type DefinitionTermNode struct {
 	Type          NodeType `json:"type"`
 	Text          string   `json:"text"`
 	Length        int      `json:"length"`
 	Line          int      `json:"line,omitempty"`
 	StartPosition int      `json:"startPosition,omitempty"`
+	NodeList      `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NodeType returns the Node type of DefinitionTermNode.
@@ -1140,26 +1569,70 @@ func (d DefinitionTermNode) String() string { return fmt.Sprintf("%#v", d) }
 
 // MarshalJSON satisfies the Marshaler interface.
 func (d DefinitionTermNode) MarshalJSON() ([]byte, error) {
+	nl := d.NodeList
+	if nl == nil {
+		nl = NodeList{}
+	}
 	return json.Marshal(&struct {
-		Type          string `json:"type"`
-		Text          string `json:"text"`
-		Length        int    `json:"length"`
-		Line          int    `json:"line,omitempty"`
-		StartPosition int    `json:"startPosition,omitempty"`
+		Type          string   `json:"type"`
+		Text          string   `json:"text"`
+		Length        int      `json:"length"`
+		Line          int      `json:"line,omitempty"`
+		StartPosition int      `json:"startPosition,omitempty"`
+		NodeList      NodeList `json:"nodeList"`
 	}{
 		Type:          nodeTypes[d.Type],
 		Text:          d.Text,
 		Length:        d.Length,
 		Line:          d.Line,
 		StartPosition: d.StartPosition,
+		NodeList:      nl,
 	})
 }
 
+// ClassifierNode is a classifier of a definition list term. Classifiers follow the term on the term line, separated from the
+// term and each other by " : ".
+type ClassifierNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewClassifier initializes a new ClassifierNode.
+func NewClassifier() *ClassifierNode {
+	return &ClassifierNode{Type: NodeClassifier}
+}
+
+// NodeType returns the Node type of ClassifierNode.
+func (c ClassifierNode) NodeType() NodeType { return c.Type }
+
+// String satisfies the Stringer interface
+func (c ClassifierNode) String() string { return fmt.Sprintf("%#v", c) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (c ClassifierNode) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	buffer.WriteString(fmt.Sprintf("\"type\": %q,", c.Type.String()))
+	b, err := json.Marshal(c.NodeList)
+	if err != nil {
+		return nil, err
+	}
+	if string(b) == "null" {
+		b = []byte{'[', ' ', ']'}
+	}
+	buffer.WriteString(fmt.Sprintf("\"nodeList\": %s", string(b)))
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
 // DefinitionNode defines a difinition element.
 type DefinitionNode struct {
 	Type     NodeType `json:"type"`
 	Line     int      `json:"line,omitempty"`
 	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
 }
 
 // NodeType returns the Node type of DefinitionNode.
@@ -1186,3 +1659,1116 @@ func (d DefinitionNode) MarshalJSON() ([]byte, error) {
 	buffer.WriteString("}")
 	return buffer.Bytes(), nil
 }
+
+// AdmonitionNode is a parsed admonition directive. Kind is the directive name, i.e., "note", "warning", or "admonition" for
+// generic admonitions. Generic admonitions have a title parsed from the directive argument.
+type AdmonitionNode struct {
+	Type          NodeType   `json:"type"`
+	Kind          string     `json:"kind"`
+	Title         *TitleNode `json:"title,omitempty"`
+	Classes       []string   `json:"classes,omitempty"`
+	IDs           []string   `json:"ids,omitempty"`
+	Names         []string   `json:"names,omitempty"`
+	DupNames      []string   `json:"dupnames,omitempty"`
+	Line          int        `json:"line,omitempty"`
+	StartPosition int        `json:"startPosition,omitempty"`
+	NodeList      `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewAdmonition returns an AdmonitionNode of kind starting at the explicit markup start i.
+func NewAdmonition(kind string, i *tok.Item) *AdmonitionNode {
+	return &AdmonitionNode{
+		Type:          NodeAdmonition,
+		Kind:          kind,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the AdmonitionNode.
+func (a AdmonitionNode) NodeType() NodeType { return a.Type }
+
+// String satisfies the Stringer interface
+func (a AdmonitionNode) String() string { return fmt.Sprintf("%#v", a) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (a AdmonitionNode) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	buffer.WriteString(fmt.Sprintf("\"type\": %q,", a.Type.String()))
+	buffer.WriteString(fmt.Sprintf("\"kind\": %q,", a.Kind))
+	if a.Title != nil {
+		t, err := json.Marshal(a.Title)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("\"title\": %s,", string(t)))
+	}
+	for _, f := range []struct {
+		name string
+		list []string
+	}{{"classes", a.Classes}, {"ids", a.IDs}, {"names", a.Names}, {"dupnames", a.DupNames}} {
+		if len(f.list) == 0 {
+			continue
+		}
+		l, err := json.Marshal(f.list)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(fmt.Sprintf("%q: %s,", f.name, string(l)))
+	}
+	buffer.WriteString(fmt.Sprintf("\"line\": %d,", a.Line))
+	buffer.WriteString(fmt.Sprintf("\"startPosition\": %d,", a.StartPosition))
+	n, err := json.Marshal(a.NodeList)
+	if err != nil {
+		return nil, err
+	}
+	if string(n) == "null" {
+		n = []byte{'[', ' ', ']'}
+	}
+	buffer.WriteString(fmt.Sprintf("\"nodeList\": %s", string(n)))
+	buffer.WriteString("}")
+	return buffer.Bytes(), nil
+}
+
+// ImageNode is a parsed image directive. Height and Width contain the validated length including the unit, i.e., "10px"
+// or "50%". Scale is the scale percentage, it is zero if not set. Target is the URI or reference name the image links to.
+type ImageNode struct {
+	Type          NodeType `json:"type"`
+	URI           string   `json:"uri"`
+	Alt           string   `json:"alt,omitempty"`
+	Height        string   `json:"height,omitempty"`
+	Width         string   `json:"width,omitempty"`
+	Scale         int      `json:"scale,omitempty"`
+	Align         string   `json:"align,omitempty"`
+	Target        string   `json:"target,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+	IDs           []string `json:"ids,omitempty"`
+	Names         []string `json:"names,omitempty"`
+	DupNames      []string `json:"dupnames,omitempty"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewImage returns an ImageNode for uri starting at the explicit markup start i.
+func NewImage(uri string, i *tok.Item) *ImageNode {
+	return &ImageNode{
+		Type:          NodeImage,
+		URI:           uri,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the ImageNode.
+func (i ImageNode) NodeType() NodeType { return i.Type }
+
+// String satisfies the Stringer interface
+func (i ImageNode) String() string { return fmt.Sprintf("%#v", i) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (i ImageNode) MarshalJSON() ([]byte, error) {
+	type image ImageNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*image
+	}{
+		Type:  nodeTypes[i.Type],
+		image: (*image)(&i),
+	})
+}
+
+// FigureNode is a parsed figure directive. Width and Classes are set by the figwidth and figclass options.
+type FigureNode struct {
+	Type          NodeType     `json:"type"`
+	Image         *ImageNode   `json:"image"`
+	Caption       *CaptionNode `json:"caption,omitempty"`
+	Legend        *LegendNode  `json:"legend,omitempty"`
+	Width         string       `json:"width,omitempty"`
+	Align         string       `json:"align,omitempty"`
+	Classes       []string     `json:"classes,omitempty"`
+	Line          int          `json:"line,omitempty"`
+	StartPosition int          `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewFigure returns a FigureNode containing img.
+func NewFigure(img *ImageNode) *FigureNode {
+	return &FigureNode{
+		Type:          NodeFigure,
+		Image:         img,
+		Line:          img.Line,
+		StartPosition: img.StartPosition,
+	}
+}
+
+// NodeType returns the Node type of the FigureNode.
+func (f FigureNode) NodeType() NodeType { return f.Type }
+
+// String satisfies the Stringer interface
+func (f FigureNode) String() string { return fmt.Sprintf("%#v", f) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (f FigureNode) MarshalJSON() ([]byte, error) {
+	type figure FigureNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*figure
+	}{
+		Type:   nodeTypes[f.Type],
+		figure: (*figure)(&f),
+	})
+}
+
+// CaptionNode contains the inline elements of a figure caption.
+type CaptionNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NodeType returns the Node type of the CaptionNode.
+func (c CaptionNode) NodeType() NodeType { return c.Type }
+
+// String satisfies the Stringer interface
+func (c CaptionNode) String() string { return fmt.Sprintf("%#v", c) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (c CaptionNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[c.Type],
+		NodeList: c.NodeList,
+	})
+}
+
+// LegendNode contains the body elements of a figure legend.
+type LegendNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NodeType returns the Node type of the LegendNode.
+func (l LegendNode) NodeType() NodeType { return l.Type }
+
+// String satisfies the Stringer interface
+func (l LegendNode) String() string { return fmt.Sprintf("%#v", l) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (l LegendNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[l.Type],
+		NodeList: l.NodeList,
+	})
+}
+
+// TopicNode is a block of body elements with an optional title that is not part of the section structure. The contents
+// directive creates a TopicNode containing the table of contents.
+type TopicNode struct {
+	Type          NodeType   `json:"type"`
+	Title         *TitleNode `json:"title,omitempty"`
+	Classes       []string   `json:"classes,omitempty"`
+	IDs           []string   `json:"ids,omitempty"`
+	Names         []string   `json:"names,omitempty"`
+	DupNames      []string   `json:"dupnames,omitempty"`
+	Line          int        `json:"line,omitempty"`
+	StartPosition int        `json:"startPosition,omitempty"`
+	NodeList      `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTopic returns a TopicNode starting at the explicit markup start i.
+func NewTopic(i *tok.Item) *TopicNode {
+	return &TopicNode{
+		Type:          NodeTopic,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the TopicNode.
+func (t TopicNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TopicNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TopicNode) MarshalJSON() ([]byte, error) {
+	nl := t.NodeList
+	if nl == nil {
+		nl = NodeList{}
+	}
+	return json.Marshal(&struct {
+		Type          string     `json:"type"`
+		Title         *TitleNode `json:"title,omitempty"`
+		Classes       []string   `json:"classes,omitempty"`
+		IDs           []string   `json:"ids,omitempty"`
+		Names         []string   `json:"names,omitempty"`
+		DupNames      []string   `json:"dupnames,omitempty"`
+		Line          int        `json:"line,omitempty"`
+		StartPosition int        `json:"startPosition,omitempty"`
+		NodeList      NodeList   `json:"nodeList"`
+	}{
+		Type:          nodeTypes[t.Type],
+		Title:         t.Title,
+		Classes:       t.Classes,
+		IDs:           t.IDs,
+		Names:         t.Names,
+		DupNames:      t.DupNames,
+		Line:          t.Line,
+		StartPosition: t.StartPosition,
+		NodeList:      nl,
+	})
+}
+
+// ReferenceNode is an inline reference to the element with the id RefID, or to the external URI RefURI. ID is the id of the reference itself, it is used
+// by elements linking back to the reference. NodeList contains the inline nodes of the reference text.
+type ReferenceNode struct {
+	Type     NodeType `json:"type"`
+	RefID    string   `json:"refid,omitempty"`
+	RefURI   string   `json:"refuri,omitempty"`
+	ID       string   `json:"id,omitempty"`
+	Classes  []string `json:"classes,omitempty"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewReference returns a ReferenceNode to refID containing the nodes in nl.
+func NewReference(refID string, nl NodeList) *ReferenceNode {
+	return &ReferenceNode{Type: NodeReference, RefID: refID, NodeList: nl}
+}
+
+// NewExternalReference returns a ReferenceNode to the URI refURI containing the nodes in nl.
+func NewExternalReference(refURI string, nl NodeList) *ReferenceNode {
+	return &ReferenceNode{Type: NodeReference, RefURI: refURI, NodeList: nl}
+}
+
+// NodeType returns the Node type of the ReferenceNode.
+func (r ReferenceNode) NodeType() NodeType { return r.Type }
+
+// String satisfies the Stringer interface
+func (r ReferenceNode) String() string { return fmt.Sprintf("%#v", r) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (r ReferenceNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		RefID    string   `json:"refid,omitempty"`
+		RefURI   string   `json:"refuri,omitempty"`
+		ID       string   `json:"id,omitempty"`
+		Classes  []string `json:"classes,omitempty"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[r.Type],
+		RefID:    r.RefID,
+		RefURI:   r.RefURI,
+		ID:       r.ID,
+		Classes:  r.Classes,
+		NodeList: r.NodeList,
+	})
+}
+
+// GeneratedNode is inline text that is not part of the input but added by a transform. The classes of the node identify
+// the transform so renderers can style the text separately, i.e., "sectnum" for section numbers.
+type GeneratedNode struct {
+	Type    NodeType `json:"type"`
+	Text    string   `json:"text"`
+	Length  int      `json:"length"`
+	Classes []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewGenerated returns a GeneratedNode containing text with the classes.
+func NewGenerated(text string, classes ...string) *GeneratedNode {
+	return &GeneratedNode{
+		Type:    NodeGenerated,
+		Text:    text,
+		Length:  utf8.RuneCountInString(text),
+		Classes: classes,
+	}
+}
+
+// NodeType returns the Node type of the GeneratedNode.
+func (g GeneratedNode) NodeType() NodeType { return g.Type }
+
+// String satisfies the Stringer interface
+func (g GeneratedNode) String() string { return fmt.Sprintf("%#v", g) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (g GeneratedNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type    string   `json:"type"`
+		Text    string   `json:"text"`
+		Length  int      `json:"length"`
+		Classes []string `json:"classes,omitempty"`
+	}{
+		Type:    nodeTypes[g.Type],
+		Text:    g.Text,
+		Length:  g.Length,
+		Classes: g.Classes,
+	})
+}
+
+// RawNode contains content created by the raw directive or a role derived from the raw role. Format contains the space
+// separated names of the output formats the content is for, i.e., "html" or "latex". Renderers only output raw content of
+// their own format.
+type RawNode struct {
+	Type          NodeType `json:"type"`
+	Format        string   `json:"format"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Classes       []string `json:"classes,omitempty"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewRaw returns a RawNode for format containing the text of i.
+func NewRaw(format string, i *tok.Item) *RawNode {
+	return &RawNode{
+		Type:          NodeRaw,
+		Format:        format,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the RawNode.
+func (r RawNode) NodeType() NodeType { return r.Type }
+
+// String satisfies the Stringer interface
+func (r RawNode) String() string { return fmt.Sprintf("%#v", r) }
+
+// HasFormat returns true if format is one of the formats of the raw content.
+func (r RawNode) HasFormat(format string) bool {
+	for _, f := range strings.Fields(r.Format) {
+		if strings.EqualFold(f, format) {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalJSON satisfies the Marshaler interface.
+func (r RawNode) MarshalJSON() ([]byte, error) {
+	type raw RawNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*raw
+	}{
+		Type: nodeTypes[r.Type],
+		raw:  (*raw)(&r),
+	})
+}
+
+// MathNode contains inline math created by the math role. Text is the LaTeX source of the math. Renderers choose how to
+// output the math.
+type MathNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Classes       []string `json:"classes,omitempty"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewMath returns a MathNode containing the LaTeX source in the text of i.
+func NewMath(i *tok.Item) *MathNode {
+	return &MathNode{
+		Type:          NodeMath,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the MathNode.
+func (m MathNode) NodeType() NodeType { return m.Type }
+
+// String satisfies the Stringer interface
+func (m MathNode) String() string { return fmt.Sprintf("%#v", m) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (m MathNode) MarshalJSON() ([]byte, error) {
+	type math MathNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*math
+	}{
+		Type: nodeTypes[m.Type],
+		math: (*math)(&m),
+	})
+}
+
+// MathBlockNode contains a block of math created by the math directive. Text is the LaTeX source of the math. Renderers
+// choose how to output the math.
+type MathBlockNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Classes       []string `json:"classes,omitempty"`
+	IDs           []string `json:"ids,omitempty"`
+	Names         []string `json:"names,omitempty"`
+	DupNames      []string `json:"dupnames,omitempty"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewMathBlock returns a MathBlockNode containing the LaTeX source in the text of i.
+func NewMathBlock(i *tok.Item) *MathBlockNode {
+	return &MathBlockNode{
+		Type:          NodeMathBlock,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the MathBlockNode.
+func (m MathBlockNode) NodeType() NodeType { return m.Type }
+
+// String satisfies the Stringer interface
+func (m MathBlockNode) String() string { return fmt.Sprintf("%#v", m) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (m MathBlockNode) MarshalJSON() ([]byte, error) {
+	type mathBlock MathBlockNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*mathBlock
+	}{
+		Type:      nodeTypes[m.Type],
+		mathBlock: (*mathBlock)(&m),
+	})
+}
+
+// TableNode is a table created by the list-table and csv-table directives. NodeList contains an optional TableHeadNode
+// followed by a TableBodyNode. The first StubColumns entries of each body row are row headers. ColumnWidths contains the
+// relative widths of the columns if they were given explicitly.
+type TableNode struct {
+	Type          NodeType   `json:"type"`
+	Title         *TitleNode `json:"title,omitempty"`
+	Classes       []string   `json:"classes,omitempty"`
+	IDs           []string   `json:"ids,omitempty"`
+	Names         []string   `json:"names,omitempty"`
+	DupNames      []string   `json:"dupnames,omitempty"`
+	Align         string     `json:"align,omitempty"`
+	Width         string     `json:"width,omitempty"`
+	ColumnWidths  []int      `json:"columnWidths,omitempty"`
+	StubColumns   int        `json:"stubColumns,omitempty"`
+	Line          int        `json:"line,omitempty"`
+	StartPosition int        `json:"startPosition,omitempty"`
+	NodeList      `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTable returns an empty TableNode starting at i.
+func NewTable(i *tok.Item) *TableNode {
+	return &TableNode{
+		Type:          NodeTable,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// Head returns the TableHeadNode of the table, or nil if the table has no header rows.
+func (t TableNode) Head() *TableHeadNode {
+	for _, n := range t.NodeList {
+		if h, ok := n.(*TableHeadNode); ok {
+			return h
+		}
+	}
+	return nil
+}
+
+// Body returns the TableBodyNode of the table.
+func (t TableNode) Body() *TableBodyNode {
+	for _, n := range t.NodeList {
+		if b, ok := n.(*TableBodyNode); ok {
+			return b
+		}
+	}
+	return nil
+}
+
+// NodeType returns the Node type of the TableNode.
+func (t TableNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TableNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TableNode) MarshalJSON() ([]byte, error) {
+	nl := t.NodeList
+	if nl == nil {
+		nl = NodeList{}
+	}
+	return json.Marshal(&struct {
+		Type          string     `json:"type"`
+		Title         *TitleNode `json:"title,omitempty"`
+		Classes       []string   `json:"classes,omitempty"`
+		IDs           []string   `json:"ids,omitempty"`
+		Names         []string   `json:"names,omitempty"`
+		DupNames      []string   `json:"dupnames,omitempty"`
+		Align         string     `json:"align,omitempty"`
+		Width         string     `json:"width,omitempty"`
+		ColumnWidths  []int      `json:"columnWidths,omitempty"`
+		StubColumns   int        `json:"stubColumns,omitempty"`
+		Line          int        `json:"line,omitempty"`
+		StartPosition int        `json:"startPosition,omitempty"`
+		NodeList      NodeList   `json:"nodeList"`
+	}{
+		Type:          nodeTypes[t.Type],
+		Title:         t.Title,
+		Classes:       t.Classes,
+		IDs:           t.IDs,
+		Names:         t.Names,
+		DupNames:      t.DupNames,
+		Align:         t.Align,
+		Width:         t.Width,
+		ColumnWidths:  t.ColumnWidths,
+		StubColumns:   t.StubColumns,
+		Line:          t.Line,
+		StartPosition: t.StartPosition,
+		NodeList:      nl,
+	})
+}
+
+// TableHeadNode contains the header rows of a table.
+type TableHeadNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTableHead returns a TableHeadNode containing rows.
+func NewTableHead(rows ...*TableRowNode) *TableHeadNode {
+	h := &TableHeadNode{Type: NodeTableHead, NodeList: NodeList{}}
+	for _, r := range rows {
+		h.Append(r)
+	}
+	return h
+}
+
+// NodeType returns the Node type of the TableHeadNode.
+func (t TableHeadNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TableHeadNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TableHeadNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[t.Type],
+		NodeList: t.NodeList,
+	})
+}
+
+// TableBodyNode contains the body rows of a table.
+type TableBodyNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTableBody returns a TableBodyNode containing rows.
+func NewTableBody(rows ...*TableRowNode) *TableBodyNode {
+	b := &TableBodyNode{Type: NodeTableBody, NodeList: NodeList{}}
+	for _, r := range rows {
+		b.Append(r)
+	}
+	return b
+}
+
+// NodeType returns the Node type of the TableBodyNode.
+func (t TableBodyNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TableBodyNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TableBodyNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[t.Type],
+		NodeList: t.NodeList,
+	})
+}
+
+// TableRowNode is a row of a table. NodeList contains the TableEntryNodes of the row.
+type TableRowNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTableRow returns a TableRowNode containing entries.
+func NewTableRow(entries ...*TableEntryNode) *TableRowNode {
+	r := &TableRowNode{Type: NodeTableRow, NodeList: NodeList{}}
+	for _, e := range entries {
+		r.Append(e)
+	}
+	return r
+}
+
+// NodeType returns the Node type of the TableRowNode.
+func (t TableRowNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TableRowNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TableRowNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[t.Type],
+		NodeList: t.NodeList,
+	})
+}
+
+// TableEntryNode is a cell of a table. NodeList contains the body elements of the cell and is empty for empty cells.
+type TableEntryNode struct {
+	Type     NodeType `json:"type"`
+	NodeList `json:"nodeList"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTableEntry returns a TableEntryNode containing the body elements nl.
+func NewTableEntry(nl NodeList) *TableEntryNode {
+	if nl == nil {
+		nl = NodeList{}
+	}
+	return &TableEntryNode{Type: NodeTableEntry, NodeList: nl}
+}
+
+// NodeType returns the Node type of the TableEntryNode.
+func (t TableEntryNode) NodeType() NodeType { return t.Type }
+
+// String satisfies the Stringer interface
+func (t TableEntryNode) String() string { return fmt.Sprintf("%#v", t) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (t TableEntryNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Type     string   `json:"type"`
+		NodeList NodeList `json:"nodeList"`
+	}{
+		Type:     nodeTypes[t.Type],
+		NodeList: t.NodeList,
+	})
+}
+
+// SubscriptNode is inline text created by the subscript role.
+type SubscriptNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewSubscript returns a SubscriptNode containing the text of i.
+func NewSubscript(i *tok.Item) *SubscriptNode {
+	return &SubscriptNode{
+		Type:          NodeSubscript,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the SubscriptNode.
+func (n SubscriptNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n SubscriptNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n SubscriptNode) MarshalJSON() ([]byte, error) {
+	type subscript SubscriptNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*subscript
+	}{
+		Type:      nodeTypes[n.Type],
+		subscript: (*subscript)(&n),
+	})
+}
+
+// SuperscriptNode is inline text created by the superscript role.
+type SuperscriptNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewSuperscript returns a SuperscriptNode containing the text of i.
+func NewSuperscript(i *tok.Item) *SuperscriptNode {
+	return &SuperscriptNode{
+		Type:          NodeSuperscript,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the SuperscriptNode.
+func (n SuperscriptNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n SuperscriptNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n SuperscriptNode) MarshalJSON() ([]byte, error) {
+	type superscript SuperscriptNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*superscript
+	}{
+		Type:        nodeTypes[n.Type],
+		superscript: (*superscript)(&n),
+	})
+}
+
+// TitleReferenceNode is the title of a book, article, or other work created by the title-reference role.
+type TitleReferenceNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewTitleReference returns a TitleReferenceNode containing the text of i.
+func NewTitleReference(i *tok.Item) *TitleReferenceNode {
+	return &TitleReferenceNode{
+		Type:          NodeTitleReference,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the TitleReferenceNode.
+func (n TitleReferenceNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n TitleReferenceNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n TitleReferenceNode) MarshalJSON() ([]byte, error) {
+	type titleReference TitleReferenceNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*titleReference
+	}{
+		Type:           nodeTypes[n.Type],
+		titleReference: (*titleReference)(&n),
+	})
+}
+
+// AbbreviationNode is an abbreviation or acronym created by the abbreviation role.
+type AbbreviationNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Length        int      `json:"length"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	Classes       []string `json:"classes,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewAbbreviation returns a AbbreviationNode containing the text of i.
+func NewAbbreviation(i *tok.Item) *AbbreviationNode {
+	return &AbbreviationNode{
+		Type:          NodeAbbreviation,
+		Text:          i.Text,
+		Length:        i.Length,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the AbbreviationNode.
+func (n AbbreviationNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n AbbreviationNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n AbbreviationNode) MarshalJSON() ([]byte, error) {
+	type abbreviation AbbreviationNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*abbreviation
+	}{
+		Type:         nodeTypes[n.Type],
+		abbreviation: (*abbreviation)(&n),
+	})
+}
+
+// PendingNode marks the position of the directive that created it in the document. The node is replaced or removed by a
+// transform when parsing is complete, i.e., the class directive applies its classes to the element following the node.
+type PendingNode struct {
+	Type          NodeType `json:"type"`
+	Directive     string   `json:"directive"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewPending returns a PendingNode for the directive name at the position of i.
+func NewPending(name string, i *tok.Item) *PendingNode {
+	return &PendingNode{Type: NodePending, Directive: name, Line: i.Line, StartPosition: i.StartPosition, Span: NewSpan(i)}
+}
+
+// NodeType returns the Node type of the PendingNode.
+func (n PendingNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n PendingNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n PendingNode) MarshalJSON() ([]byte, error) {
+	type pending PendingNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*pending
+	}{
+		Type:    nodeTypes[n.Type],
+		pending: (*pending)(&n),
+	})
+}
+
+// MetaNode is metadata about the document created by the meta directive. Name and HTTPEquiv are the name and http-equiv
+// attributes of the HTML meta element, only one of them is usually set. Attributes contains any other attributes, i.e.,
+// "lang" or "scheme".
+type MetaNode struct {
+	Type       NodeType          `json:"type"`
+	Name       string            `json:"name,omitempty"`
+	HTTPEquiv  string            `json:"httpEquiv,omitempty"`
+	Content    string            `json:"content"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	Line       int               `json:"line,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewMeta returns a MetaNode with the content at line.
+func NewMeta(content string, line int) *MetaNode {
+	return &MetaNode{Type: NodeMeta, Content: content, Line: line}
+}
+
+// NodeType returns the Node type of the MetaNode.
+func (n MetaNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n MetaNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n MetaNode) MarshalJSON() ([]byte, error) {
+	type meta MetaNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*meta
+	}{
+		Type: nodeTypes[n.Type],
+		meta: (*meta)(&n),
+	})
+}
+
+// DocumentTitleNode is the title of the document set by the title directive. The title is metadata, it is not displayed in
+// the document body. If the document contains more than one, the last one is used.
+type DocumentTitleNode struct {
+	Type          NodeType `json:"type"`
+	Text          string   `json:"text"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewDocumentTitle returns a DocumentTitleNode containing the text of i.
+func NewDocumentTitle(i *tok.Item) *DocumentTitleNode {
+	return &DocumentTitleNode{
+		Type:          NodeDocumentTitle,
+		Text:          i.Text,
+		Line:          i.Line,
+		StartPosition: i.StartPosition,
+		Span:          NewSpan(i),
+	}
+}
+
+// NodeType returns the Node type of the DocumentTitleNode.
+func (n DocumentTitleNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n DocumentTitleNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n DocumentTitleNode) MarshalJSON() ([]byte, error) {
+	type documentTitle DocumentTitleNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*documentTitle
+	}{
+		Type:          nodeTypes[n.Type],
+		documentTitle: (*documentTitle)(&n),
+	})
+}
+
+// HyperlinkTargetNode is an explicit hyperlink target, i.e., ".. _name: http://example.com". RefURI is set for external
+// targets and RefName for indirect targets that refer to another target by name. Internal targets have neither and point to
+// their position in the document. Anonymous targets have no name.
+type HyperlinkTargetNode struct {
+	Type          NodeType `json:"type"`
+	RefURI        string   `json:"refuri,omitempty"`
+	RefName       string   `json:"refname,omitempty"`
+	Anonymous     bool     `json:"anonymous,omitempty"`
+	IDs           []string `json:"ids,omitempty"`
+	Names         []string `json:"names,omitempty"`
+	DupNames      []string `json:"dupnames,omitempty"`
+	Line          int      `json:"line,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewHyperlinkTarget returns a HyperlinkTargetNode for the target beginning at the explicit markup start i.
+func NewHyperlinkTarget(i *tok.Item) *HyperlinkTargetNode {
+	return &HyperlinkTargetNode{Type: NodeHyperlinkTarget, Line: i.Line, StartPosition: i.StartPosition, Span: NewSpan(i)}
+}
+
+// NodeType returns the Node type of the HyperlinkTargetNode.
+func (n HyperlinkTargetNode) NodeType() NodeType { return n.Type }
+
+// String satisfies the Stringer interface
+func (n HyperlinkTargetNode) String() string { return fmt.Sprintf("%#v", n) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (n HyperlinkTargetNode) MarshalJSON() ([]byte, error) {
+	type hyperlinkTarget HyperlinkTargetNode
+	return json.Marshal(&struct {
+		Type string `json:"type"`
+		*hyperlinkTarget
+	}{
+		Type:            nodeTypes[n.Type],
+		hyperlinkTarget: (*hyperlinkTarget)(&n),
+	})
+}
+
+// DocumentNode is the root element of a document. Title and Subtitle are set by the document title transform from the
+// titles of the sections promoted to the document. IDs, Names and Classes are those of the section promoted to the title.
+type DocumentNode struct {
+	Type     NodeType   `json:"type"`
+	Title    *TitleNode `json:"title,omitempty"`
+	Subtitle *TitleNode `json:"subtitle,omitempty"`
+	IDs      []string   `json:"ids,omitempty"`
+	Names    []string   `json:"names,omitempty"`
+	Classes  []string   `json:"classes,omitempty"`
+	NodeList `json:"nodeList"`
+
+	// Namespace contains the reference names defined in the document, it is complete when parsing is finished.
+	Namespace *Namespace `json:"-"`
+
+	Span Span `json:"-"` // The location of the node in the input
+}
+
+// NewDocument returns an empty DocumentNode.
+func NewDocument() *DocumentNode {
+	return &DocumentNode{Type: NodeDocument, NodeList: make(NodeList, 0), Namespace: NewNamespace()}
+}
+
+// NodeType returns the Node type of the DocumentNode.
+func (d DocumentNode) NodeType() NodeType { return d.Type }
+
+// String satisfies the Stringer interface
+func (d DocumentNode) String() string { return fmt.Sprintf("%#v", d) }
+
+// MarshalJSON satisfies the Marshaler interface.
+func (d DocumentNode) MarshalJSON() ([]byte, error) {
+	nl := d.NodeList
+	if nl == nil {
+		nl = NodeList{}
+	}
+	return json.Marshal(&struct {
+		Type     string     `json:"type"`
+		Title    *TitleNode `json:"title,omitempty"`
+		Subtitle *TitleNode `json:"subtitle,omitempty"`
+		IDs      []string   `json:"ids,omitempty"`
+		Names    []string   `json:"names,omitempty"`
+		Classes  []string   `json:"classes,omitempty"`
+		NodeList NodeList   `json:"nodeList"`
+	}{
+		Type:     nodeTypes[d.Type],
+		Title:    d.Title,
+		Subtitle: d.Subtitle,
+		IDs:      d.IDs,
+		Names:    d.Names,
+		Classes:  d.Classes,
+		NodeList: nl,
+	})
+}
@@ -93,6 +93,9 @@ func (nt *NodeTarget) SetParent(n Node) {
 	case *TitleNode:
 		nt.SubList = &n.(*TitleNode).NodeList
 		nt.Parent = n
+	case *AdmonitionNode:
+		nt.SubList = &n.(*AdmonitionNode).NodeList
+		nt.Parent = n
 	default:
 		nt.Msgr("WARNING: type not supported or doesn't have a NodeList!", "type", fmt.Sprintf("%T", t))
 	}
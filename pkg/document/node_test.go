@@ -74,9 +74,23 @@ func TestDefinitionNodeType(t *testing.T) {
 	}
 }
 
+func TestClassifierNodeType(t *testing.T) {
+	n := NewClassifier()
+	if n.NodeType() != NodeClassifier {
+		t.Error("n.Type != NodeClassifier")
+	}
+}
+
 func TestBulletListType(t *testing.T) {
 	n := &BulletListNode{Type: NodeBulletList}
 	if n.NodeType() != NodeBulletList {
 		t.Error("n.Type != NodeBulletList")
 	}
 }
+
+func TestAttributionNodeType(t *testing.T) {
+	n := &AttributionNode{Type: NodeAttribution}
+	if n.NodeType() != NodeAttribution {
+		t.Error("n.Type != NodeAttribution")
+	}
+}
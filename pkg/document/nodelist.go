@@ -36,3 +36,146 @@ func (l *NodeList) MarshalJSON() ([]byte, error) {
 	buffer.WriteString("]")
 	return buffer.Bytes(), nil
 }
+
+// Children returns a pointer to the NodeList containing the body elements of n. Nil is returned if n cannot contain body
+// elements.
+func Children(n Node) *NodeList {
+	switch t := n.(type) {
+	case *DocumentNode:
+		return &t.NodeList
+	case *SectionNode:
+		return &t.NodeList
+	case *ParagraphNode:
+		return &t.NodeList
+	case *BlockQuoteNode:
+		return &t.NodeList
+	case *AttributionNode:
+		return &t.NodeList
+	case *BulletListNode:
+		return &t.NodeList
+	case *BulletListItemNode:
+		return &t.NodeList
+	case *EnumListNode:
+		return &t.NodeList
+	case *EnumListItemNode:
+		return &t.NodeList
+	case *DefinitionListNode:
+		return &t.NodeList
+	case *DefinitionListItemNode:
+		if t.Definition != nil {
+			return &t.Definition.NodeList
+		}
+	case *DefinitionNode:
+		return &t.NodeList
+	case *AdmonitionNode:
+		return &t.NodeList
+	case *TopicNode:
+		return &t.NodeList
+	case *FigureNode:
+		if t.Legend != nil {
+			return &t.Legend.NodeList
+		}
+	case *LegendNode:
+		return &t.NodeList
+	case *TableNode:
+		return &t.NodeList
+	case *TableHeadNode:
+		return &t.NodeList
+	case *TableBodyNode:
+		return &t.NodeList
+	case *TableRowNode:
+		return &t.NodeList
+	case *TableEntryNode:
+		return &t.NodeList
+	}
+	return nil
+}
+
+// Classes returns a pointer to the class names of the body element n. Nil is returned if n cannot have classes.
+func Classes(n Node) *[]string {
+	switch t := n.(type) {
+	case *SectionNode:
+		return &t.Classes
+	case *ParagraphNode:
+		return &t.Classes
+	case *BlockQuoteNode:
+		return &t.Classes
+	case *BulletListNode:
+		return &t.Classes
+	case *EnumListNode:
+		return &t.Classes
+	case *DefinitionListNode:
+		return &t.Classes
+	case *LiteralBlockNode:
+		return &t.Classes
+	case *TransitionNode:
+		return &t.Classes
+	case *AdmonitionNode:
+		return &t.Classes
+	case *ImageNode:
+		return &t.Classes
+	case *FigureNode:
+		return &t.Classes
+	case *TopicNode:
+		return &t.Classes
+	case *TableNode:
+		return &t.Classes
+	case *RawNode:
+		return &t.Classes
+	case *MathBlockNode:
+		return &t.Classes
+	}
+	return nil
+}
+
+// Walk calls fn for each node in l and their children in document order. If fn returns false, the children of the node are
+// not visited.
+func (l NodeList) Walk(fn func(n Node) bool) {
+	for _, n := range l {
+		if !fn(n) {
+			continue
+		}
+		if c := Children(n); c != nil {
+			c.Walk(fn)
+		}
+	}
+}
+
+// Remove removes n from l or from the children of the nodes in l. False is returned if n is not found.
+func (l *NodeList) Remove(n Node) bool {
+	for i, x := range *l {
+		if x == n {
+			*l = append((*l)[:i], (*l)[i+1:]...)
+			return true
+		}
+		if c := Children(x); c != nil && c.Remove(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// Text returns the text of the inline nodes in l concatenated, without markup. Text added by transforms, such as section
+// numbers, is not included.
+func (l NodeList) Text() string {
+	var s string
+	for _, n := range l {
+		switch t := n.(type) {
+		case *TextNode:
+			s += t.Text
+		case *InlineEmphasisNode:
+			s += t.Text
+		case *InlineStrongNode:
+			s += t.Text
+		case *InlineLiteralNode:
+			s += t.Text
+		case *InlineInterpretedText:
+			s += t.Text
+		case *InlineNode:
+			s += t.Text
+		case *ReferenceNode:
+			s += t.NodeList.Text()
+		}
+	}
+	return s
+}
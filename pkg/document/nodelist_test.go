@@ -1 +1,45 @@
 package document
+
+import (
+	"testing"
+
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func TestNodeListRemoveNested(t *testing.T) {
+	topic := NewTopic(&tok.Item{Line: 3})
+	sub := &SectionNode{Type: NodeSection, Level: 2, NodeList: NodeList{topic}}
+	sec := &SectionNode{Type: NodeSection, Level: 1, NodeList: NodeList{NewParagraph(), sub}}
+	nl := NodeList{sec}
+
+	var sections int
+	nl.Walk(func(n Node) bool {
+		if _, ok := n.(*SectionNode); ok {
+			sections++
+		}
+		return true
+	})
+	if sections != 2 {
+		t.Errorf("Walk visited %d sections, expect 2", sections)
+	}
+	if !nl.Remove(topic) {
+		t.Fatal("expect topic to be removed")
+	}
+	if len(sub.NodeList) != 0 {
+		t.Errorf("expect subsection to be empty, got %d nodes", len(sub.NodeList))
+	}
+	if nl.Remove(topic) {
+		t.Error("expect second Remove to return false")
+	}
+}
+
+func TestNodeListText(t *testing.T) {
+	nl := NodeList{
+		NewText(&tok.Item{Text: "Section "}),
+		NewInlineEmphasis(&tok.Item{Text: "one"}),
+		NewReference("two", NodeList{NewText(&tok.Item{Text: " two"})}),
+	}
+	if got := nl.Text(); got != "Section one two" {
+		t.Errorf("got %q, expect %q", got, "Section one two")
+	}
+}
@@ -0,0 +1,616 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/demizer/go-rst/pkg/log"
+	"github.com/demizer/go-rst/pkg/mathml"
+	"github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// HTML type for rendering the document to HTML5.
+// Do not initialize this directly. Call HTMLRenderer instead.
+type HTML struct {
+	Messages *NodeList
+	Nodes    *NodeList
+	Document *DocumentNode // The root node, if rendering a document with a title
+
+	logConf log.Config
+	log.Logger
+}
+
+// admonitionTitles are the titles of the specific admonitions.
+var admonitionTitles = map[string]string{
+	"attention": "Attention!",
+	"caution":   "Caution!",
+	"danger":    "!DANGER!",
+	"error":     "Error",
+	"hint":      "Hint",
+	"important": "Important",
+	"note":      "Note",
+	"tip":       "Tip",
+	"warning":   "Warning",
+}
+
+// enumListClasses are the classes of ordered lists by enumeration type. Auto enumerated lists are arabic.
+var enumListClasses = map[EnumListType]string{
+	enumListArabic:     "arabic",
+	enumListUpperAlpha: "upperalpha",
+	enumListLowerAlpha: "loweralpha",
+	enumListUpperRoman: "upperroman",
+	enumListLowerRoman: "lowerroman",
+	enumListAuto:       "arabic",
+}
+
+// severityLevels are the numeric levels of system message severities used in system message titles.
+var severityLevels = map[string]int{"INFO": 1, "WARNING": 2, "ERROR": 3, "SEVERE": 4}
+
+// Bytes renders the document as a standalone HTML5 document. System messages are rendered in a section at the end of the
+// document, followed by the messages generated while rendering. Info messages are not rendered, this is the default report
+// level of docutils.
+func (h HTML) Bytes() ([]byte, error) {
+	w := &htmlWriter{Logger: h.Logger}
+	w.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\" />\n")
+	w.head(*h.Nodes, h.Document)
+	w.WriteString("</head>\n<body>\n")
+	w.main(h.Document)
+	w.nodeList(*h.Nodes)
+	var messages NodeList
+	if h.Messages != nil {
+		for _, m := range *h.Messages {
+			if sm, ok := m.(*SystemMessageNode); ok && severityLevels[sm.Severity] < severityLevels["WARNING"] {
+				continue
+			}
+			messages = append(messages, m)
+		}
+	}
+	messages = append(messages, w.messages...)
+	if len(messages) > 0 {
+		w.WriteString("<section class=\"system-messages\">\n<h1>Docutils System Messages</h1>\n")
+		w.nodeList(messages)
+		w.WriteString("</section>\n")
+	}
+	w.WriteString("</main>\n</body>\n</html>\n")
+	return w.Bytes(), nil
+}
+
+// HTMLRenderer returns the Renderer interface
+func HTMLRenderer(logConf log.Config, messages, nodes *NodeList) Renderer {
+	conf := logConf
+	conf.Name = "document_html"
+	return HTML{
+		Messages: messages,
+		Nodes:    nodes,
+		logConf:  conf,
+		Logger:   log.NewLogger(conf),
+	}
+}
+
+// HTMLDocumentRenderer returns the Renderer interface for the document d. The document title and subtitle are rendered at
+// the start of the document.
+func HTMLDocumentRenderer(logConf log.Config, messages *NodeList, d *DocumentNode) Renderer {
+	h := HTMLRenderer(logConf, messages, &d.NodeList).(HTML)
+	h.Document = d
+	return h
+}
+
+// htmlWriter writes nodes as HTML to a buffer.
+type htmlWriter struct {
+	bytes.Buffer
+	log.Logger
+
+	messages NodeList // The system messages generated while rendering
+	depth    int      // The number of headings enclosing the current section, including the document title
+}
+
+// attr returns an attribute with the HTML escaped value in double quotes, preceded by a space.
+func attr(name, value string) string {
+	return " " + name + "=\"" + html.EscapeString(value) + "\""
+}
+
+// classAttr returns a class attribute containing classes. If there are no classes, an empty string is returned.
+func classAttr(classes ...string) string {
+	var c []string
+	for _, class := range classes {
+		if class != "" {
+			c = append(c, class)
+		}
+	}
+	if len(c) == 0 {
+		return ""
+	}
+	return attr("class", strings.Join(c, " "))
+}
+
+// idAttr returns an id attribute containing the first of ids. If there are no ids, an empty string is returned.
+func idAttr(ids []string) string {
+	if len(ids) == 0 {
+		return ""
+	}
+	return attr("id", ids[0])
+}
+
+func (w *htmlWriter) text(s string) { w.WriteString(html.EscapeString(s)) }
+
+func (w *htmlWriter) nodeList(nl NodeList) {
+	for _, n := range nl {
+		w.node(n)
+	}
+}
+
+func (w *htmlWriter) node(n Node) {
+	switch t := n.(type) {
+	case *SectionNode:
+		// The heading level is the nesting depth of the section, HTML has six levels
+		level := min(w.depth+1, 6)
+		fmt.Fprintf(w, "<section%s%s>\n", classAttr(t.Classes...), idAttr(t.IDs))
+		if t.Title != nil {
+			fmt.Fprintf(w, "<h%d>", level)
+			w.title(t.Title)
+			fmt.Fprintf(w, "</h%d>\n", level)
+		}
+		w.depth++
+		w.nodeList(t.NodeList)
+		w.depth--
+		w.WriteString("</section>\n")
+	case *ParagraphNode:
+		fmt.Fprintf(w, "<p%s>", classAttr(t.Classes...))
+		w.nodeList(t.NodeList)
+		w.WriteString("</p>\n")
+	case *TextNode:
+		w.text(t.Text)
+	case *InlineEmphasisNode:
+		fmt.Fprintf(w, "<em%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</em>")
+	case *InlineStrongNode:
+		fmt.Fprintf(w, "<strong%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</strong>")
+	case *SubscriptNode:
+		fmt.Fprintf(w, "<sub%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</sub>")
+	case *SuperscriptNode:
+		fmt.Fprintf(w, "<sup%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</sup>")
+	case *TitleReferenceNode:
+		fmt.Fprintf(w, "<cite%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</cite>")
+	case *AbbreviationNode:
+		fmt.Fprintf(w, "<abbr%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</abbr>")
+	case *InlineLiteralNode:
+		if len(t.Classes) > 0 && t.Classes[0] == "code" {
+			fmt.Fprintf(w, "<code%s>", classAttr(t.Classes...))
+			w.literalText(t.Text, t.NodeList)
+			w.WriteString("</code>")
+			break
+		}
+		fmt.Fprintf(w, "<span%s>", classAttr(append([]string{"docutils", "literal"}, t.Classes...)...))
+		w.text(t.Text)
+		w.WriteString("</span>")
+	case *InlineInterpretedText:
+		// The default role is title-reference
+		w.WriteString("<cite>")
+		w.text(t.Text)
+		w.WriteString("</cite>")
+	case *InlineInterpretedTextRole:
+		// A role without interpreted text is written as it appears in the input
+		w.text(":" + t.Text + ":")
+	case *InlineNode:
+		fmt.Fprintf(w, "<span%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</span>")
+	case *GeneratedNode:
+		fmt.Fprintf(w, "<span%s>", classAttr(t.Classes...))
+		w.text(t.Text)
+		w.WriteString("</span>")
+	case *LiteralBlockNode:
+		fmt.Fprintf(w, "<pre%s%s>", classAttr(append(t.Classes, "literal-block")...), idAttr(t.IDs))
+		w.literalText(t.Text, t.NodeList)
+		w.WriteString("</pre>\n")
+	case *BlockQuoteNode:
+		fmt.Fprintf(w, "<blockquote%s>\n", classAttr(t.Classes...))
+		w.nodeList(t.NodeList)
+		w.WriteString("</blockquote>\n")
+	case *AttributionNode:
+		w.WriteString("<p class=\"attribution\">—")
+		w.nodeList(t.NodeList)
+		w.WriteString("</p>\n")
+	case *BulletListNode:
+		fmt.Fprintf(w, "<ul%s>\n", classAttr(append(t.Classes, "simple")...))
+		w.nodeList(t.NodeList)
+		w.WriteString("</ul>\n")
+	case *BulletListItemNode:
+		w.WriteString("<li>")
+		w.nodeList(t.NodeList)
+		w.WriteString("</li>\n")
+	case *EnumListNode:
+		fmt.Fprintf(w, "<ol%s", classAttr(append(t.Classes, enumListClasses[t.EnumType], "simple")...))
+		if t.Start != 1 {
+			fmt.Fprintf(w, " start=\"%d\"", t.Start)
+		}
+		w.WriteString(">\n")
+		w.nodeList(t.NodeList)
+		w.WriteString("</ol>\n")
+	case *EnumListItemNode:
+		w.WriteString("<li>")
+		w.nodeList(t.NodeList)
+		w.WriteString("</li>\n")
+	case *DefinitionListNode:
+		fmt.Fprintf(w, "<dl%s>\n", classAttr(append(t.Classes, "simple")...))
+		w.nodeList(t.NodeList)
+		w.WriteString("</dl>\n")
+	case *DefinitionListItemNode:
+		if t.Term != nil {
+			w.WriteString("<dt>")
+			if t.Term.NodeList != nil {
+				w.nodeList(t.Term.NodeList)
+			} else {
+				w.text(t.Term.Text)
+			}
+			for _, c := range t.Classifiers {
+				w.WriteString("<span class=\"classifier\">")
+				w.nodeList(c.NodeList)
+				w.WriteString("</span>")
+			}
+			w.WriteString("</dt>\n")
+		}
+		if t.Definition != nil {
+			w.WriteString("<dd>")
+			w.nodeList(t.Definition.NodeList)
+			w.WriteString("</dd>\n")
+		}
+	case *TransitionNode:
+		fmt.Fprintf(w, "<hr%s />\n", classAttr(append(t.Classes, "docutils")...))
+	case *CommentNode:
+		w.WriteString("<!-- ")
+		w.WriteString(strings.Replace(t.Text, "--", "- -", -1))
+		w.WriteString(" -->\n")
+	case *AdmonitionNode:
+		classes := append([]string{"admonition"}, t.Classes...)
+		if t.Kind != "admonition" {
+			classes = append([]string{"admonition", t.Kind}, t.Classes...)
+		}
+		fmt.Fprintf(w, "<aside%s%s>\n<p class=\"admonition-title\">", classAttr(classes...), idAttr(t.IDs))
+		if t.Title != nil {
+			w.nodeList(t.Title.NodeList)
+		} else {
+			w.text(admonitionTitles[t.Kind])
+		}
+		w.WriteString("</p>\n")
+		w.nodeList(t.NodeList)
+		w.WriteString("</aside>\n")
+	case *ImageNode:
+		w.image(t)
+		w.WriteString("\n")
+	case *FigureNode:
+		var style string
+		if t.Width != "" {
+			style = fmt.Sprintf(" style=\"width: %s\"", html.EscapeString(cssLength(t.Width)))
+		}
+		align := ""
+		if t.Align != "" {
+			align = "align-" + t.Align
+		}
+		fmt.Fprintf(w, "<figure%s%s>\n", classAttr(append([]string{align}, t.Classes...)...), style)
+		w.image(t.Image)
+		w.WriteString("\n")
+		if t.Caption != nil || t.Legend != nil {
+			w.WriteString("<figcaption>\n")
+			if t.Caption != nil {
+				w.WriteString("<p>")
+				w.nodeList(t.Caption.NodeList)
+				w.WriteString("</p>\n")
+			}
+			if t.Legend != nil {
+				w.WriteString("<div class=\"legend\">\n")
+				w.nodeList(t.Legend.NodeList)
+				w.WriteString("</div>\n")
+			}
+			w.WriteString("</figcaption>\n")
+		}
+		w.WriteString("</figure>\n")
+	case *TopicNode:
+		tag := "div"
+		if len(t.Classes) > 0 && t.Classes[0] == "contents" {
+			tag = "nav"
+		}
+		fmt.Fprintf(w, "<%s%s%s>\n", tag, classAttr(append([]string{"topic"}, t.Classes...)...), idAttr(t.IDs))
+		if t.Title != nil {
+			w.WriteString("<p class=\"topic-title\">")
+			w.nodeList(t.Title.NodeList)
+			w.WriteString("</p>\n")
+		}
+		w.nodeList(t.NodeList)
+		fmt.Fprintf(w, "</%s>\n", tag)
+	case *ReferenceNode:
+		if t.RefURI != "" {
+			fmt.Fprintf(w, "<a%s%s", classAttr(append([]string{"reference", "external"}, t.Classes...)...),
+				attr("href", t.RefURI))
+		} else {
+			fmt.Fprintf(w, "<a%s href=\"#%s\"", classAttr(append([]string{"reference", "internal"}, t.Classes...)...),
+				html.EscapeString(t.RefID))
+		}
+		if t.ID != "" {
+			w.WriteString(attr("id", t.ID))
+		}
+		w.WriteString(">")
+		w.nodeList(t.NodeList)
+		w.WriteString("</a>")
+	case *RawNode:
+		if t.HasFormat("html") {
+			w.WriteString(t.Text)
+		}
+	case *MathNode:
+		if m, err := mathml.Convert(t.Text, false); err == nil {
+			fmt.Fprintf(w, "<span%s>%s</span>", classAttr(append([]string{"math"}, t.Classes...)...), m)
+		} else {
+			w.mathError(t.Text, t.Line, err)
+			fmt.Fprintf(w, "<span%s>", classAttr(append([]string{"math", "problematic"}, t.Classes...)...))
+			w.text(t.Text)
+			w.WriteString("</span>")
+		}
+	case *MathBlockNode:
+		if m, err := mathml.Convert(t.Text, true); err == nil {
+			fmt.Fprintf(w, "<div%s%s>\n%s\n</div>\n", classAttr(append([]string{"math"}, t.Classes...)...), idAttr(t.IDs), m)
+		} else {
+			w.mathError(t.Text, t.Line, err)
+			fmt.Fprintf(w, "<pre%s%s>", classAttr(append([]string{"math", "problematic"}, t.Classes...)...), idAttr(t.IDs))
+			w.text(t.Text)
+			w.WriteString("</pre>\n")
+		}
+	case *TableNode:
+		w.table(t)
+	case *SystemMessageNode:
+		w.systemMessage(t)
+	case *HyperlinkTargetNode:
+		// External and indirect targets are only used to resolve references
+		if t.RefURI == "" && t.RefName == "" && len(t.IDs) > 0 {
+			fmt.Fprintf(w, "<span%s></span>\n", idAttr(t.IDs))
+		}
+	case *MetaNode, *DocumentTitleNode, *PendingNode:
+		// Metadata is written to the document head
+	default:
+		w.Msgr("WARNING: node type not supported by the HTML renderer", "type", fmt.Sprintf("%T", t))
+	}
+}
+
+// head writes the metadata of the document contained in nl, the meta elements and title, to the document head.
+func (w *htmlWriter) head(nl NodeList, d *DocumentNode) {
+	var title *DocumentTitleNode
+	nl.Walk(func(n Node) bool {
+		switch t := n.(type) {
+		case *DocumentTitleNode:
+			title = t
+		case *MetaNode:
+			w.meta(t)
+		}
+		return true
+	})
+	if title != nil {
+		w.WriteString("<title>")
+		w.text(title.Text)
+		w.WriteString("</title>\n")
+	} else if d != nil && d.Title != nil {
+		w.WriteString("<title>")
+		w.text(d.Title.NodeList.Text())
+		w.WriteString("</title>\n")
+	}
+}
+
+// main writes the start of the main element with the title and subtitle of d. The id and classes of the main element are
+// those of the section promoted to the document title. The title is the first heading level, so the headings of the
+// sections of a document with a title start at the second level.
+func (w *htmlWriter) main(d *DocumentNode) {
+	if d == nil {
+		w.WriteString("<main>\n")
+		return
+	}
+	fmt.Fprintf(w, "<main%s%s>\n", classAttr(d.Classes...), idAttr(d.IDs))
+	if d.Title != nil {
+		w.depth = 1
+		w.WriteString("<h1 class=\"title\">")
+		w.title(d.Title)
+		w.WriteString("</h1>\n")
+	}
+	if d.Subtitle != nil {
+		fmt.Fprintf(w, "<p class=\"subtitle\"%s>", idAttr(d.Subtitle.IDs))
+		w.title(d.Subtitle)
+		w.WriteString("</p>\n")
+	}
+}
+
+// meta writes a meta element.
+func (w *htmlWriter) meta(m *MetaNode) {
+	w.WriteString("<meta")
+	if m.Name != "" {
+		w.WriteString(attr("name", m.Name))
+	}
+	if m.HTTPEquiv != "" {
+		w.WriteString(attr("http-equiv", m.HTTPEquiv))
+	}
+	var attrs []string
+	for a := range m.Attributes {
+		attrs = append(attrs, a)
+	}
+	sort.Strings(attrs)
+	for _, a := range attrs {
+		w.WriteString(attr(html.EscapeString(a), m.Attributes[a]))
+	}
+	fmt.Fprintf(w, "%s />\n", attr("content", m.Content))
+}
+
+// title writes the inline nodes of a section title. If the title has a RefID, the text links back to the element with that
+// id, i.e., the table of contents entry of the section.
+func (w *htmlWriter) title(t *TitleNode) {
+	if t.RefID == "" {
+		w.nodeList(t.NodeList)
+		return
+	}
+	fmt.Fprintf(w, "<a class=\"toc-backref\" href=\"#%s\" role=\"doc-backlink\">", html.EscapeString(t.RefID))
+	w.nodeList(t.NodeList)
+	w.WriteString("</a>")
+}
+
+// table writes a table element. Header cells and the cells of stub columns are th elements. A cell containing a single
+// paragraph is written without the p element.
+func (w *htmlWriter) table(t *TableNode) {
+	classes := t.Classes
+	if t.Align != "" {
+		classes = append(append([]string{}, classes...), "align-"+t.Align)
+	}
+	fmt.Fprintf(w, "<table%s%s", classAttr(classes...), idAttr(t.IDs))
+	if t.Width != "" {
+		fmt.Fprintf(w, " style=\"width: %s;\"", html.EscapeString(cssLength(t.Width)))
+	}
+	w.WriteString(">\n")
+	if t.Title != nil {
+		w.WriteString("<caption>")
+		w.nodeList(t.Title.NodeList)
+		w.WriteString("</caption>\n")
+	}
+	if len(t.ColumnWidths) > 0 {
+		total := 0
+		for _, c := range t.ColumnWidths {
+			total += c
+		}
+		w.WriteString("<colgroup>\n")
+		for _, c := range t.ColumnWidths {
+			fmt.Fprintf(w, "<col style=\"width: %d%%\" />\n", c*100/total)
+		}
+		w.WriteString("</colgroup>\n")
+	}
+	rows := func(tag string, nl NodeList, head bool) {
+		fmt.Fprintf(w, "<%s>\n", tag)
+		for _, n := range nl {
+			row, ok := n.(*TableRowNode)
+			if !ok {
+				continue
+			}
+			w.WriteString("<tr>")
+			for i, e := range row.NodeList {
+				cell, class := "td", ""
+				if head {
+					cell, class = "th", "head"
+				} else if i < t.StubColumns {
+					cell, class = "th", "stub"
+				}
+				fmt.Fprintf(w, "<%s%s>", cell, classAttr(class))
+				if entry := e.(*TableEntryNode); len(entry.NodeList) == 1 && entry.NodeList[0].NodeType() == NodeParagraph {
+					w.nodeList(entry.NodeList[0].(*ParagraphNode).NodeList)
+				} else {
+					w.nodeList(entry.NodeList)
+				}
+				fmt.Fprintf(w, "</%s>", cell)
+			}
+			w.WriteString("</tr>\n")
+		}
+		fmt.Fprintf(w, "</%s>\n", tag)
+	}
+	if h := t.Head(); h != nil {
+		rows("thead", h.NodeList, true)
+	}
+	if b := t.Body(); b != nil {
+		rows("tbody", b.NodeList, false)
+	}
+	w.WriteString("</table>\n")
+}
+
+// cssLength returns a length option value as a CSS length. Unitless values are pixels.
+func cssLength(l string) string {
+	if l != "" && strings.IndexFunc(l, func(r rune) bool { return r != '.' && (r < '0' || r > '9') }) == -1 {
+		return l + "px"
+	}
+	return l
+}
+
+// image writes an img element. If the image has a target, the element is wrapped in a link.
+func (w *htmlWriter) image(i *ImageNode) {
+	if i.Target != "" {
+		fmt.Fprintf(w, "<a class=\"reference external image-reference\"%s>", attr("href", i.Target))
+	}
+	alt := i.Alt
+	if alt == "" {
+		alt = i.URI
+	}
+	fmt.Fprintf(w, "<img%s%s", attr("alt", alt), attr("src", i.URI))
+	var style []string
+	if i.Width != "" {
+		style = append(style, "width: "+cssLength(i.Width)+";")
+	}
+	if i.Height != "" {
+		style = append(style, "height: "+cssLength(i.Height)+";")
+	}
+	if len(style) > 0 {
+		w.WriteString(attr("style", strings.Join(style, " ")))
+	}
+	align := ""
+	if i.Align != "" {
+		align = "align-" + i.Align
+	}
+	fmt.Fprintf(w, "%s%s />", classAttr(append([]string{align}, i.Classes...)...), idAttr(i.IDs))
+	if i.Target != "" {
+		w.WriteString("</a>")
+	}
+}
+
+// literalText writes the text of a literal element. If the element contains highlighted nodes, they are written instead
+// of the text.
+func (w *htmlWriter) literalText(text string, nl NodeList) {
+	if len(nl) == 0 {
+		w.text(text)
+		return
+	}
+	w.nodeList(nl)
+}
+
+// mathError adds an error system message for the math at line that could not be converted to MathML. The LaTeX source of
+// the math is added to the message as a literal block.
+func (w *htmlWriter) mathError(text string, line int, err error) {
+	w.Msgr("cannot convert math to MathML", "error", err.Error())
+	pm := messages.NewParserMessage(messages.MathErrorMathML)
+	pm.Args = []interface{}{err}
+	sm := NewSystemMessage(pm, line)
+	sm.Append(NewLiteralBlock(&tok.Item{Text: text, Length: utf8.RuneCountInString(text)}))
+	w.messages = append(w.messages, sm)
+}
+
+func (w *htmlWriter) systemMessage(s *SystemMessageNode) {
+	fmt.Fprintf(w, "<aside class=\"system-message\">\n<p class=\"system-message-title\">System Message: %s/%d",
+		s.Severity, severityLevels[s.Severity])
+	if s.Source != "" || s.Line > 0 {
+		w.WriteString(" (")
+		if s.Source != "" {
+			w.text(s.Source)
+			if s.Line > 0 {
+				w.WriteString(", ")
+			}
+		}
+		if s.Line > 0 {
+			fmt.Fprintf(w, "line %d", s.Line)
+		}
+		w.WriteString(")")
+	}
+	w.WriteString("</p>\n")
+	for _, n := range s.NodeList {
+		if t, ok := n.(*TextNode); ok {
+			w.WriteString("<p>")
+			w.text(t.Text)
+			w.WriteString("</p>\n")
+			continue
+		}
+		w.node(n)
+	}
+	w.WriteString("</aside>\n")
+}
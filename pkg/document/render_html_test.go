@@ -0,0 +1,239 @@
+package document
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/demizer/go-rst/pkg/testutil"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func TestHTMLRendererCode(t *testing.T) {
+	lb := NewLiteralBlock(&tok.Item{Text: "x := 1 < 2"})
+	lb.Classes = []string{"code", "go"}
+	lb.IDs = []string{"assign"}
+	lb.NodeList = NodeList{
+		NewInline("x", "name"),
+		NewText(&tok.Item{Text: " "}),
+		NewInline(":=", "operator"),
+		NewText(&tok.Item{Text: " "}),
+		NewInline("1", "literal", "number"),
+		NewText(&tok.Item{Text: " "}),
+		NewInline("<", "operator"),
+		NewText(&tok.Item{Text: " "}),
+		NewInline("2", "literal", "number"),
+	}
+	var messages NodeList
+	nodes := NodeList{lb}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `<pre class="code go literal-block" id="assign"><span class="name">x</span> <span class="operator">:=</span> ` +
+		`<span class="literal number">1</span> <span class="operator">&lt;</span> <span class="literal number">2</span></pre>`
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	if strings.Contains(string(out), "system-messages") {
+		t.Error("expect no system messages section")
+	}
+}
+
+func TestHTMLRendererFigure(t *testing.T) {
+	img := NewImage(`images\a b.png`, &tok.Item{Line: 1})
+	img.Width = "200"
+	img.Target = "https://example.com"
+	fig := NewFigure(img)
+	fig.Align = "center"
+	fig.Caption = &CaptionNode{Type: NodeCaption, NodeList: NodeList{NewText(&tok.Item{Text: "A <caption>"})}}
+	var messages NodeList
+	nodes := NodeList{fig}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<figure class=\"align-center\">\n<a class=\"reference external image-reference\" " +
+		"href=\"https://example.com\"><img alt=\"images\\a b.png\" src=\"images\\a b.png\" style=\"width: 200px;\" />" +
+		"</a>\n<figcaption>\n<p>A &lt;caption&gt;</p>\n</figcaption>\n</figure>\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestHTMLRendererRaw(t *testing.T) {
+	var messages NodeList
+	nodes := NodeList{
+		NewRaw("html", &tok.Item{Text: "<hr>"}),
+		NewRaw("latex", &tok.Item{Text: `\hrule`}),
+	}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "<hr>") {
+		t.Errorf("expect html raw content in output, got\n%s", out)
+	}
+	if strings.Contains(string(out), "hrule") {
+		t.Errorf("expect no latex raw content in output, got\n%s", out)
+	}
+}
+
+func TestHTMLRendererMath(t *testing.T) {
+	var messages NodeList
+	p := NewParagraph()
+	p.Append(NewMath(&tok.Item{Text: `x^2`}))
+	nodes := NodeList{p, NewMathBlock(&tok.Item{Text: `\frac{1}{2}`}),
+		NewMathBlock(&tok.Item{Text: `\unknown < 1`, Line: 7})}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		`<p><span class="math"><math xmlns="http://www.w3.org/1998/Math/MathML"><msup><mi>x</mi><mn>2</mn></msup></math></span></p>`,
+		"<div class=\"math\">\n<math xmlns=\"http://www.w3.org/1998/Math/MathML\" display=\"block\"><mfrac><mn>1</mn>" +
+			"<mn>2</mn></mfrac></math>\n</div>\n",
+		`<pre class="math problematic">\unknown &lt; 1</pre>`,
+		"<h1>Docutils System Messages</h1>\n<aside class=\"system-message\">\n" +
+			"<p class=\"system-message-title\">System Message: ERROR/3 (line 7)</p>\n" +
+			"<p>Cannot convert math to MathML: unknown LaTeX command \\unknown.</p>\n" +
+			"<pre class=\"literal-block\">\\unknown &lt; 1</pre>\n</aside>\n",
+	} {
+		if !strings.Contains(string(out), expect) {
+			t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+		}
+	}
+}
+
+func TestHTMLRendererTable(t *testing.T) {
+	cell := func(text string) *TableEntryNode {
+		p := NewParagraph()
+		p.Append(NewText(&tok.Item{Text: text}))
+		return NewTableEntry(NodeList{p})
+	}
+	table := NewTable(&tok.Item{})
+	table.ColumnWidths = []int{1, 3}
+	table.StubColumns = 1
+	table.Append(NewTableHead(NewTableRow(cell("a"), cell("b"))))
+	table.Append(NewTableBody(NewTableRow(cell("c"), NewTableEntry(nil))))
+	var messages NodeList
+	nodes := NodeList{table}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<table>\n<colgroup>\n<col style=\"width: 25%\" />\n<col style=\"width: 75%\" />\n</colgroup>\n" +
+		"<thead>\n<tr><th class=\"head\">a</th><th class=\"head\">b</th></tr>\n</thead>\n" +
+		"<tbody>\n<tr><th class=\"stub\">c</th><td></td></tr>\n</tbody>\n</table>\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestHTMLRendererMetadata(t *testing.T) {
+	var messages NodeList
+	keywords := NewMeta("plaintext, markup", 1)
+	keywords.Name = "keywords"
+	contentType := NewMeta("text/html; charset=utf-8", 2)
+	contentType.HTTPEquiv = "Content-Type"
+	description := NewMeta("An amusing story", 3)
+	description.Name = "description"
+	description.Attributes = map[string]string{"lang": "en"}
+	p := NewParagraphWithNodeText(&tok.Item{Text: "Text"})
+	p.Classes = []string{"special"}
+	nodes := NodeList{
+		NewDocumentTitle(&tok.Item{Text: "Fish & Chips"}), keywords, contentType, description, p,
+	}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<head>\n<meta charset=\"utf-8\" />\n" +
+		"<meta name=\"keywords\" content=\"plaintext, markup\" />\n" +
+		"<meta http-equiv=\"Content-Type\" content=\"text/html; charset=utf-8\" />\n" +
+		"<meta name=\"description\" lang=\"en\" content=\"An amusing story\" />\n" +
+		"<title>Fish &amp; Chips</title>\n</head>\n<body>\n<main>\n<p class=\"special\">Text</p>\n</main>"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	// Attribute names are escaped
+	bad := NewMeta("a", 1)
+	bad.Attributes = map[string]string{`x"><script>`: "y"}
+	nodes = NodeList{bad}
+	out, err = HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = "<meta x&#34;&gt;&lt;script&gt;=\"y\" content=\"a\" />\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestHTMLRendererAttributes(t *testing.T) {
+	var messages NodeList
+	ref := NewExternalReference(`C:\dir`, NodeList{NewText(&tok.Item{Text: "dir"})})
+	ref.ID = "a\u00a0\"b\""
+	ref.Classes = []string{`c\d`}
+	target := &HyperlinkTargetNode{Type: NodeHyperlinkTarget, IDs: []string{"x<y"}}
+	p := NewParagraph()
+	p.NodeList = NodeList{ref}
+	nodes := NodeList{p, target}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<p><a class=\"reference external c\\d\" href=\"C:\\dir\" id=\"a\u00a0&#34;b&#34;\">dir</a></p>\n" +
+		"<span id=\"x&lt;y\"></span>\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestHTMLRendererDocumentTitle(t *testing.T) {
+	var messages NodeList
+	d := NewDocument()
+	d.Title = NewTitleNodeWithText(&tok.Item{Text: "Fish & Chips"})
+	d.IDs = []string{"fish-chips"}
+	d.Subtitle = NewTitleNodeWithText(&tok.Item{Text: "A Recipe"})
+	d.Subtitle.IDs = []string{"a-recipe"}
+	d.Append(NewParagraphWithNodeText(&tok.Item{Text: "Text"}))
+	out, err := HTMLDocumentRenderer(testutil.LoggerConfig, &messages, d).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<title>Fish &amp; Chips</title>\n</head>\n<body>\n<main id=\"fish-chips\">\n" +
+		"<h1 class=\"title\">Fish &amp; Chips</h1>\n<p class=\"subtitle\" id=\"a-recipe\">A Recipe</p>\n<p>Text</p>\n</main>"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestHTMLRendererHeadingLevels(t *testing.T) {
+	section := func(title string, level int, nl ...Node) *SectionNode {
+		return &SectionNode{Type: NodeSection, Level: level, Title: NewTitleNodeWithText(&tok.Item{Text: title}),
+			NodeList: nl}
+	}
+	var messages NodeList
+	// Without a document title the top level sections are the first heading level
+	nodes := NodeList{section("One", 1, section("Two", 2, section("Three", 3)))}
+	out, err := HTMLRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "<h1>One</h1>\n<section>\n<h2>Two</h2>\n<section>\n<h3>Three</h3>\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	// The sections of a promoted document title keep their levels, the headings follow the nesting
+	d := NewDocument()
+	d.Title = NewTitleNodeWithText(&tok.Item{Text: "Title"})
+	d.Append(section("Two", 2, section("Three", 3)))
+	out, err = HTMLDocumentRenderer(testutil.LoggerConfig, &messages, d).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = "<h1 class=\"title\">Title</h1>\n<section>\n<h2>Two</h2>\n<section>\n<h3>Three</h3>\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
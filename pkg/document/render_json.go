@@ -12,6 +12,7 @@ import (
 type JSON struct {
 	Messages *NodeList
 	Nodes    *NodeList
+	Document *DocumentNode // The root node, rendered in place of Nodes if set
 
 	logConf log.Config
 	log.Logger
@@ -23,7 +24,11 @@ func (j JSON) Bytes() ([]byte, error) {
 	// j.DumpExit(j.Messages)
 	tmp.Append(NewSystemMessagesNode())
 	tmp.LastNode().(*SystemMessagesNode).NodeList.Append(*j.Messages...)
-	tmp.Append(*j.Nodes...)
+	if j.Document != nil {
+		tmp.Append(j.Document)
+	} else {
+		tmp.Append(*j.Nodes...)
+	}
 
 	pJson, err := json.MarshalIndent(tmp, "", "    ")
 	if err != nil {
@@ -46,3 +51,11 @@ func JsonRenderer(logConf log.Config, messages, nodes *NodeList) Renderer {
 	// t.DumpExit(messages)
 	return t
 }
+
+// JsonDocumentRenderer returns the Renderer interface for the document d. The DocumentNode is rendered after the system
+// messages.
+func JsonDocumentRenderer(logConf log.Config, messages *NodeList, d *DocumentNode) Renderer {
+	j := JsonRenderer(logConf, messages, &d.NodeList).(JSON)
+	j.Document = d
+	return j
+}
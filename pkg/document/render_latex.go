@@ -0,0 +1,704 @@
+package document
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/demizer/go-rst/pkg/log"
+)
+
+// LaTeX type for rendering the document to a standalone LaTeX document.
+// Do not initialize this directly. Call LaTeXRenderer instead.
+type LaTeX struct {
+	Messages *NodeList
+	Nodes    *NodeList
+	Document *DocumentNode // The root node, if rendering a document with a title
+
+	// Template is the text/template used to write the document. It is executed with a LaTeXDocument. If Template is empty,
+	// DefaultLaTeXTemplate is used.
+	Template string
+
+	logConf log.Config
+	log.Logger
+}
+
+// LaTeXDocument contains the parts of a rendered document passed to the LaTeX template. All fields are LaTeX source.
+type LaTeXDocument struct {
+	Title    string // The document title, empty if the document does not have a title
+	Subtitle string // The document subtitle
+	Metadata string // The hyperref options set from the document metadata, i.e., the PDF title and keywords
+	Body     string // The rendered nodes of the document
+}
+
+// DefaultLaTeXTemplate is the template used to write a document if the Template of the LaTeX renderer is not set. Sections
+// are not numbered, this is the default of docutils.
+const DefaultLaTeXTemplate = `\documentclass[a4paper]{article}
+\usepackage[T1]{fontenc}
+\usepackage[utf8]{inputenc}
+\usepackage{lmodern}
+\usepackage{textcomp}
+\usepackage{amsmath}
+\usepackage{graphicx}
+\usepackage{listings}
+\usepackage{marginnote}
+\usepackage{enumitem}
+\usepackage{hyperref}
+\setcounter{secnumdepth}{0}
+\lstset{basicstyle=\ttfamily\small,breaklines=true,columns=fullflexible}
+{{- with .Metadata}}
+\hypersetup{ {{- .}}}
+{{- end}}
+{{- if .Title}}
+\title{ {{- .Title}}
+{{- if .Subtitle}}\\
+\large{ {{- .Subtitle}}}
+{{- end}}}
+\author{}
+\date{}
+{{- end}}
+
+\begin{document}
+{{- if .Title}}
+\maketitle
+{{- end}}
+
+{{.Body -}}
+\end{document}
+`
+
+// latexSections are the sectioning commands by section depth. Deeper sections use the last command.
+var latexSections = []string{"section", "subsection", "subsubsection", "paragraph", "subparagraph"}
+
+// latexEnumLabels are the enumitem counter commands of enumerated lists by enumeration type.
+var latexEnumLabels = map[EnumListType]string{
+	enumListArabic:     `\arabic*`,
+	enumListUpperAlpha: `\Alph*`,
+	enumListLowerAlpha: `\alph*`,
+	enumListUpperRoman: `\Roman*`,
+	enumListLowerRoman: `\roman*`,
+	enumListAuto:       `\arabic*`,
+}
+
+// latexLanguages maps the languages of code blocks to the names of the languages predefined by the listings package. Code
+// in other languages is written without highlighting.
+var latexLanguages = map[string]string{
+	"bash":   "bash",
+	"c":      "C",
+	"c++":    "C++",
+	"cpp":    "C++",
+	"html":   "HTML",
+	"java":   "Java",
+	"perl":   "Perl",
+	"python": "Python",
+	"ruby":   "Ruby",
+	"sh":     "sh",
+	"sql":    "SQL",
+	"tex":    "TeX",
+	"xml":    "XML",
+}
+
+// latexEscaper escapes the characters that have a special meaning in LaTeX. The brackets are escaped so that text is not
+// read as the optional argument of a preceding command.
+var latexEscaper = strings.NewReplacer(
+	`\`, `\textbackslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`#`, `\#`,
+	`$`, `\$`,
+	`%`, `\%`,
+	`&`, `\&`,
+	`_`, `\_`,
+	`~`, `\textasciitilde{}`,
+	`^`, `\textasciicircum{}`,
+	`[`, `{[}`,
+	`]`, `{]}`,
+)
+
+// latexEscape returns s with the special characters of LaTeX escaped. Consecutive hyphens are separated so they are not
+// written as dashes.
+func latexEscape(s string) string {
+	s = latexEscaper.Replace(s)
+	for strings.Contains(s, "--") {
+		s = strings.Replace(s, "--", "-{}-", -1)
+	}
+	return s
+}
+
+// latexURL returns the URL u escaped for the argument of \href.
+func latexURL(u string) string {
+	return strings.NewReplacer(`\`, `\\`, `{`, `\{`, `}`, `\}`, `#`, `\#`, `%`, `\%`).Replace(u)
+}
+
+// latexPathEscaper replaces the characters of a file path that cannot be written in the argument of \includegraphics with
+// commands expanding to the character. The percent sign would start a comment and braces would be unbalanced.
+var latexPathEscaper = strings.NewReplacer(
+	`\`, `/`,
+	`%`, `\csname @percentchar\endcsname`,
+	`#`, `\string#`,
+	`$`, `\string$`,
+	`&`, `\string&`,
+	`^`, `\string^`,
+	`_`, `\string_`,
+	`~`, `\string~`,
+	`{`, `\csname @charlb\endcsname`,
+	`}`, `\csname @charrb\endcsname`,
+)
+
+// latexPath returns the image URI u as a file path for the argument of \includegraphics. Percent escapes in the URI are
+// decoded, as docutils does with url2pathname.
+func latexPath(u string) string {
+	if p, err := url.PathUnescape(u); err == nil {
+		u = p
+	}
+	return latexPathEscaper.Replace(u)
+}
+
+// Bytes renders the document as a standalone LaTeX document. System messages are written as margin notes next to the
+// first block at or after the line of the message. Info messages are not rendered, this is the default report level of
+// docutils.
+func (l LaTeX) Bytes() ([]byte, error) {
+	tmpl := l.Template
+	if tmpl == "" {
+		tmpl = DefaultLaTeXTemplate
+	}
+	t, err := template.New("latex").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing LaTeX template: %s", err)
+	}
+	w := &latexWriter{Logger: l.Logger}
+	if l.Messages != nil {
+		for _, m := range *l.Messages {
+			if sm, ok := m.(*SystemMessageNode); ok && severityLevels[sm.Severity] >= severityLevels["WARNING"] {
+				w.messages = append(w.messages, sm)
+			}
+		}
+		sort.SliceStable(w.messages, func(i, j int) bool { return w.messages[i].Line < w.messages[j].Line })
+	}
+	var ld LaTeXDocument
+	if l.Document != nil {
+		if l.Document.Title != nil {
+			ld.Title = w.inlineString(l.Document.Title.NodeList)
+		}
+		if l.Document.Subtitle != nil {
+			ld.Subtitle = w.inlineString(l.Document.Subtitle.NodeList)
+		}
+		if len(l.Document.IDs) > 0 {
+			w.labels(l.Document.IDs)
+			w.WriteString("\n")
+		}
+	}
+	ld.Metadata = w.metadata(*l.Nodes, l.Document)
+	if ld.Title == "" {
+		l.Nodes.Walk(func(n Node) bool {
+			if t, ok := n.(*DocumentTitleNode); ok {
+				ld.Title = latexEscape(t.Text)
+			}
+			return true
+		})
+	}
+	w.blocks(*l.Nodes)
+	w.flushMessages(-1)
+	ld.Body = w.String()
+	var out bytes.Buffer
+	if err := t.Execute(&out, ld); err != nil {
+		return nil, fmt.Errorf("Error executing LaTeX template: %s", err)
+	}
+	return out.Bytes(), nil
+}
+
+// LaTeXRenderer returns the Renderer interface
+func LaTeXRenderer(logConf log.Config, messages, nodes *NodeList) Renderer {
+	conf := logConf
+	conf.Name = "document_latex"
+	return LaTeX{
+		Messages: messages,
+		Nodes:    nodes,
+		logConf:  conf,
+		Logger:   log.NewLogger(conf),
+	}
+}
+
+// LaTeXDocumentRenderer returns the Renderer interface for the document d. The document title and subtitle are written with
+// \maketitle.
+func LaTeXDocumentRenderer(logConf log.Config, messages *NodeList, d *DocumentNode) Renderer {
+	l := LaTeXRenderer(logConf, messages, &d.NodeList).(LaTeX)
+	l.Document = d
+	return l
+}
+
+// latexWriter writes nodes as LaTeX to a buffer.
+type latexWriter struct {
+	bytes.Buffer
+	log.Logger
+
+	depth    int                  // The depth of the current section
+	messages []*SystemMessageNode // The system messages not yet written, sorted by line
+}
+
+func (w *latexWriter) text(s string) { w.WriteString(latexEscape(s)) }
+
+// inlines writes the inline nodes of nl.
+func (w *latexWriter) inlines(nl NodeList) {
+	for _, n := range nl {
+		w.node(n)
+	}
+}
+
+// inlineString returns the inline nodes of nl written as LaTeX.
+func (w *latexWriter) inlineString(nl NodeList) string {
+	iw := &latexWriter{Logger: w.Logger}
+	iw.inlines(nl)
+	return iw.String()
+}
+
+// blocks writes the body elements of nl. Raw LaTeX in a block context is ended with a newline.
+func (w *latexWriter) blocks(nl NodeList) {
+	for _, n := range nl {
+		w.node(n)
+		if r, ok := n.(*RawNode); ok && r.HasFormat("latex") && !strings.HasSuffix(r.Text, "\n") {
+			w.WriteString("\n")
+		}
+	}
+}
+
+// labels writes a label for each of ids.
+func (w *latexWriter) labels(ids []string) {
+	for _, id := range ids {
+		fmt.Fprintf(w, `\label{%s}`, id)
+	}
+}
+
+func (w *latexWriter) node(n Node) {
+	switch t := n.(type) {
+	case *SectionNode:
+		fmt.Fprintf(w, `\%s{`, latexSections[min(w.depth, len(latexSections)-1)])
+		line := t.Span.Line
+		if t.Title != nil {
+			w.inlines(t.Title.NodeList)
+			line = t.Title.Span.EndLine
+		}
+		if t.UnderLine != nil {
+			line = t.UnderLine.Span.EndLine
+		}
+		w.WriteString("}")
+		w.labels(t.IDs)
+		w.WriteString("\n")
+		w.flushMessages(line)
+		w.WriteString("\n")
+		w.depth++
+		w.blocks(t.NodeList)
+		w.depth--
+	case *ParagraphNode:
+		w.flushMessages(t.Span.EndLine)
+		w.inlines(t.NodeList)
+		w.WriteString("\n\n")
+	case *TextNode:
+		w.text(t.Text)
+	case *InlineEmphasisNode:
+		w.command("emph", t.Text)
+	case *InlineStrongNode:
+		w.command("textbf", t.Text)
+	case *SubscriptNode:
+		w.command("textsubscript", t.Text)
+	case *SuperscriptNode:
+		w.command("textsuperscript", t.Text)
+	case *TitleReferenceNode:
+		w.command("textsl", t.Text)
+	case *InlineInterpretedText:
+		// The default role is title-reference
+		w.command("textsl", t.Text)
+	case *InlineInterpretedTextRole:
+		// A role without interpreted text is written as it appears in the input
+		w.text(":" + t.Text + ":")
+	case *AbbreviationNode:
+		w.text(t.Text)
+	case *InlineNode:
+		w.text(t.Text)
+	case *GeneratedNode:
+		w.text(t.Text)
+	case *InlineLiteralNode:
+		w.WriteString(`\texttt{`)
+		w.WriteString(strings.Replace(latexEscape(t.Text), " ", "~", -1))
+		w.WriteString("}")
+	case *LiteralBlockNode:
+		w.flushMessages(t.Span.EndLine)
+		w.literalBlock(t)
+	case *BlockQuoteNode:
+		w.WriteString("\\begin{quote}\n")
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{quote}\n\n")
+	case *AttributionNode:
+		w.WriteString("\\begin{flushright}\n---")
+		w.inlines(t.NodeList)
+		w.WriteString("\n\\end{flushright}\n")
+	case *BulletListNode:
+		w.WriteString("\\begin{itemize}\n")
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{itemize}\n\n")
+	case *BulletListItemNode:
+		w.WriteString(`\item `)
+		w.blocks(t.NodeList)
+	case *EnumListNode:
+		label := latexEnumLabels[t.EnumType]
+		switch t.Affix {
+		case enumAffixParenthesisSurround:
+			label = "(" + label + ")"
+		case enumAffixParenthesisRight:
+			label += ")"
+		default:
+			label += "."
+		}
+		fmt.Fprintf(w, "\\begin{enumerate}[label=%s", label)
+		if t.Start != 1 {
+			fmt.Fprintf(w, ",start=%d", t.Start)
+		}
+		w.WriteString("]\n")
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{enumerate}\n\n")
+	case *EnumListItemNode:
+		w.WriteString(`\item `)
+		w.blocks(t.NodeList)
+	case *DefinitionListNode:
+		w.WriteString("\\begin{description}\n")
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{description}\n\n")
+	case *DefinitionListItemNode:
+		w.WriteString(`\item[{`)
+		if t.Term != nil {
+			if t.Term.NodeList != nil {
+				w.inlines(t.Term.NodeList)
+			} else {
+				w.text(t.Term.Text)
+			}
+		}
+		for _, c := range t.Classifiers {
+			w.WriteString(` : \emph{`)
+			w.inlines(c.NodeList)
+			w.WriteString("}")
+		}
+		w.WriteString("}]\n")
+		if t.Definition != nil {
+			w.blocks(t.Definition.NodeList)
+		}
+	case *TransitionNode:
+		w.flushMessages(t.Span.EndLine)
+		w.WriteString("\\begin{center}\n\\rule{0.5\\linewidth}{0.4pt}\n\\end{center}\n\n")
+	case *CommentNode:
+		if t.Text == "" {
+			break
+		}
+		for _, line := range strings.Split(t.Text, "\n") {
+			fmt.Fprintf(w, "%% %s\n", line)
+		}
+		w.WriteString("\n")
+	case *AdmonitionNode:
+		w.WriteString("\\begin{quote}\n\\textbf{")
+		if t.Title != nil {
+			w.inlines(t.Title.NodeList)
+		} else {
+			w.text(admonitionTitles[t.Kind])
+		}
+		w.WriteString("}\n\n")
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{quote}\n\n")
+	case *ImageNode:
+		w.flushMessages(t.Span.EndLine)
+		w.aligned(t.Align, func() { w.image(t) })
+		w.WriteString("\n")
+	case *FigureNode:
+		w.flushMessages(t.Span.Line)
+		w.WriteString("\\begin{figure}[htbp]\n\\centering\n")
+		w.image(t.Image)
+		w.WriteString("\n")
+		if t.Caption != nil {
+			w.WriteString(`\caption{`)
+			w.inlines(t.Caption.NodeList)
+			w.WriteString("}\n")
+		}
+		if t.Legend != nil {
+			w.WriteString("\n")
+			w.blocks(t.Legend.NodeList)
+		}
+		w.WriteString("\\end{figure}\n\n")
+	case *TopicNode:
+		w.WriteString("\\begin{quote}\n")
+		if len(t.IDs) > 0 {
+			w.WriteString(`\phantomsection`)
+			w.labels(t.IDs)
+			w.WriteString("\n")
+		}
+		if t.Title != nil {
+			w.WriteString(`\textbf{`)
+			w.inlines(t.Title.NodeList)
+			w.WriteString("}\n\n")
+		}
+		w.blocks(t.NodeList)
+		w.WriteString("\\end{quote}\n\n")
+	case *ReferenceNode:
+		if t.RefURI != "" {
+			fmt.Fprintf(w, `\href{%s}{`, latexURL(t.RefURI))
+		} else {
+			fmt.Fprintf(w, `\hyperref[%s]{`, t.RefID)
+		}
+		w.inlines(t.NodeList)
+		w.WriteString("}")
+	case *RawNode:
+		if t.HasFormat("latex") {
+			w.WriteString(t.Text)
+		}
+	case *MathNode:
+		fmt.Fprintf(w, "$%s$", t.Text)
+	case *MathBlockNode:
+		w.flushMessages(t.Span.EndLine)
+		env := "equation*"
+		if strings.Contains(t.Text, `\\`) || strings.Contains(t.Text, "&") {
+			env = "align*"
+		}
+		fmt.Fprintf(w, "\\begin{%s}\n%s\n\\end{%s}\n\n", env, strings.TrimSpace(t.Text), env)
+	case *TableNode:
+		w.flushMessages(t.Span.Line)
+		w.table(t)
+	case *SystemMessageNode:
+		w.systemMessage(t)
+	case *HyperlinkTargetNode:
+		// External and indirect targets are only used to resolve references
+		if t.RefURI == "" && t.RefName == "" && len(t.IDs) > 0 {
+			w.WriteString(`\phantomsection`)
+			w.labels(t.IDs)
+			w.WriteString("\n")
+		}
+	case *MetaNode, *DocumentTitleNode, *PendingNode:
+		// Metadata is written to the hyperref options
+	default:
+		w.Msgr("WARNING: node type not supported by the LaTeX renderer", "type", fmt.Sprintf("%T", t))
+	}
+}
+
+// command writes the escaped text as the argument of the LaTeX command cmd.
+func (w *latexWriter) command(cmd, text string) {
+	fmt.Fprintf(w, `\%s{`, cmd)
+	w.text(text)
+	w.WriteString("}")
+}
+
+// metadata returns the hyperref options for the title, keywords and description of the document. The title is taken from
+// the document title node, or the title of d.
+func (w *latexWriter) metadata(nl NodeList, d *DocumentNode) string {
+	var title string
+	var opts []string
+	nl.Walk(func(n Node) bool {
+		switch t := n.(type) {
+		case *DocumentTitleNode:
+			title = t.Text
+		case *MetaNode:
+			switch t.Name {
+			case "keywords":
+				opts = append(opts, fmt.Sprintf("pdfkeywords={%s}", latexEscape(t.Content)))
+			case "description":
+				opts = append(opts, fmt.Sprintf("pdfsubject={%s}", latexEscape(t.Content)))
+			}
+		}
+		return true
+	})
+	if title == "" && d != nil && d.Title != nil {
+		title = d.Title.NodeList.Text()
+	}
+	if title != "" {
+		opts = append([]string{fmt.Sprintf("pdftitle={%s}", latexEscape(title))}, opts...)
+	}
+	return strings.Join(opts, ",")
+}
+
+// literalBlock writes a literal block in a verbatim environment. Code blocks in a language known to the listings package are
+// written in a lstlisting environment instead. If the text contains the end of the environment, the text is escaped and
+// written in a typewriter font with explicit line breaks, as docutils writes literal blocks by default.
+func (w *latexWriter) literalBlock(l *LiteralBlockNode) {
+	lang := l.Language
+	if lang == "" && len(l.Classes) > 1 && l.Classes[0] == "code" {
+		lang = l.Classes[1]
+	}
+	env, opt := "verbatim", ""
+	if len(l.Classes) > 0 && l.Classes[0] == "code" {
+		env = "lstlisting"
+		if name, ok := latexLanguages[strings.ToLower(lang)]; ok {
+			opt = "[language=" + name + "]"
+		}
+	}
+	text := strings.TrimRight(l.Text, "\n")
+	if !strings.Contains(text, `\end{`+env+`}`) {
+		fmt.Fprintf(w, "\\begin{%s}%s\n%s\n\\end{%s}\n\n", env, opt, text, env)
+		return
+	}
+	w.WriteString("\\begin{quote}\n{\\ttfamily \\raggedright \\noindent\n")
+	for x, line := range strings.Split(text, "\n") {
+		if x > 0 {
+			w.WriteString("\\\\\n")
+		}
+		w.WriteString(strings.Replace(latexEscape(line), " ", "~", -1))
+	}
+	w.WriteString("\n}\n\\end{quote}\n\n")
+}
+
+// aligned writes the output of f in an environment that aligns it according to align.
+func (w *latexWriter) aligned(align string, f func()) {
+	env := map[string]string{"left": "flushleft", "center": "center", "right": "flushright"}[align]
+	if env == "" {
+		f()
+		w.WriteString("\n")
+		return
+	}
+	fmt.Fprintf(w, "\\begin{%s}\n", env)
+	f()
+	fmt.Fprintf(w, "\n\\end{%s}\n", env)
+}
+
+// latexLength returns a length option value as a LaTeX length. Percentages are relative to the line width and unitless
+// values are pixels.
+func latexLength(l string) string {
+	if p := strings.TrimSuffix(l, "%"); p != l {
+		var f float64
+		if _, err := fmt.Sscanf(p, "%g", &f); err == nil {
+			return fmt.Sprintf(`%g\linewidth`, f/100)
+		}
+	}
+	return cssLength(l)
+}
+
+// image writes an \includegraphics command. If the image has a target, the image is a link.
+func (w *latexWriter) image(i *ImageNode) {
+	var opts []string
+	if i.Width != "" {
+		opts = append(opts, "width="+latexLength(i.Width))
+	}
+	if i.Height != "" {
+		opts = append(opts, "height="+latexLength(i.Height))
+	}
+	if i.Scale != 0 && i.Width == "" && i.Height == "" {
+		opts = append(opts, fmt.Sprintf("scale=%g", float64(i.Scale)/100))
+	}
+	if i.Target != "" {
+		fmt.Fprintf(w, `\href{%s}{`, latexURL(i.Target))
+	}
+	w.WriteString(`\includegraphics`)
+	if len(opts) > 0 {
+		fmt.Fprintf(w, "[%s]", strings.Join(opts, ","))
+	}
+	fmt.Fprintf(w, "{%s}", latexPath(i.URI))
+	if i.Target != "" {
+		w.WriteString("}")
+	}
+}
+
+// table writes a table float containing a tabular environment. The column widths are proportional to the ColumnWidths of
+// the table. Header cells and the cells of stub columns are bold.
+func (w *latexWriter) table(t *TableNode) {
+	var head, body NodeList
+	if h := t.Head(); h != nil {
+		head = h.NodeList
+	}
+	if b := t.Body(); b != nil {
+		body = b.NodeList
+	}
+	widths := t.ColumnWidths
+	if len(widths) == 0 {
+		columns := 0
+		for _, n := range append(append(NodeList{}, head...), body...) {
+			if row, ok := n.(*TableRowNode); ok {
+				columns = max(columns, len(row.NodeList))
+			}
+		}
+		widths = make([]int, columns)
+		for i := range widths {
+			widths[i] = 1
+		}
+	}
+	total := 0
+	for _, c := range widths {
+		total += c
+	}
+	w.WriteString("\\begin{table}[htbp]\n\\centering\n")
+	if t.Title != nil {
+		w.WriteString(`\caption{`)
+		w.inlines(t.Title.NodeList)
+		w.WriteString("}\n")
+	}
+	w.WriteString(`\begin{tabular}{|`)
+	for _, c := range widths {
+		fmt.Fprintf(w, `p{%.3f\linewidth}|`, 0.9*float64(c)/float64(total))
+	}
+	w.WriteString("}\n\\hline\n")
+	rows := func(nl NodeList, head bool) {
+		for _, n := range nl {
+			row, ok := n.(*TableRowNode)
+			if !ok {
+				continue
+			}
+			for i, e := range row.NodeList {
+				if i > 0 {
+					w.WriteString(" & ")
+				}
+				bold := head || i < t.StubColumns
+				if bold {
+					w.WriteString(`\textbf{`)
+				}
+				if entry := e.(*TableEntryNode); len(entry.NodeList) == 1 && entry.NodeList[0].NodeType() == NodeParagraph {
+					w.inlines(entry.NodeList[0].(*ParagraphNode).NodeList)
+				} else {
+					w.blocks(entry.NodeList)
+				}
+				if bold {
+					w.WriteString("}")
+				}
+			}
+			w.WriteString(" \\\\\n\\hline\n")
+		}
+	}
+	rows(head, true)
+	rows(body, false)
+	w.WriteString("\\end{tabular}\n\\end{table}\n\n")
+}
+
+// flushMessages writes the pending system messages reported at or before line as margin notes. If line is negative, all
+// pending messages are written. Nothing is written if line is zero, the node was not located.
+func (w *latexWriter) flushMessages(line int) {
+	if line == 0 {
+		return
+	}
+	for len(w.messages) > 0 && (line < 0 || w.messages[0].Line <= line) {
+		w.systemMessage(w.messages[0])
+		w.WriteString("\n")
+		w.messages = w.messages[1:]
+	}
+}
+
+// systemMessage writes a system message as a margin note. Only the text of the message is written, the literal block
+// containing the input is not.
+func (w *latexWriter) systemMessage(s *SystemMessageNode) {
+	fmt.Fprintf(w, `\marginnote{\footnotesize\textbf{System Message: %s/%d}`, s.Severity, severityLevels[s.Severity])
+	if s.Source != "" || s.Line > 0 {
+		w.WriteString(" (")
+		if s.Source != "" {
+			w.text(s.Source)
+			if s.Line > 0 {
+				w.WriteString(", ")
+			}
+		}
+		if s.Line > 0 {
+			fmt.Fprintf(w, "line %d", s.Line)
+		}
+		w.WriteString(")")
+	}
+	w.WriteString(":")
+	s.NodeList.Walk(func(n Node) bool {
+		if t, ok := n.(*TextNode); ok {
+			w.WriteString(" ")
+			w.text(t.Text)
+		}
+		return true
+	})
+	w.WriteString("}")
+}
@@ -0,0 +1,290 @@
+package document
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/demizer/go-rst/pkg/messages"
+	"github.com/demizer/go-rst/pkg/testutil"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func TestLaTeXEscape(t *testing.T) {
+	tests := []struct {
+		input, expect string
+	}{
+		{`50% of $5 & #1_a`, `50\% of \$5 \& \#1\_a`},
+		{`{C:\path}`, `\{C:\textbackslash{}path\}`},
+		{`~x^2 [1]`, `\textasciitilde{}x\textasciicircum{}2 {[}1{]}`},
+		{`--option ---`, `-{}-option -{}-{}-`},
+	}
+	for _, tt := range tests {
+		if got := latexEscape(tt.input); got != tt.expect {
+			t.Errorf("latexEscape(%q): got %q, expect %q", tt.input, got, tt.expect)
+		}
+	}
+}
+
+func TestLaTeXRendererSections(t *testing.T) {
+	section := func(title string, nl ...Node) *SectionNode {
+		return &SectionNode{Type: NodeSection, Title: NewTitleNodeWithText(&tok.Item{Text: title}),
+			IDs: []string{strings.ToLower(title)}, NodeList: nl}
+	}
+	p := NewParagraph()
+	p.Append(NewText(&tok.Item{Text: "Some "}))
+	p.Append(NewInlineEmphasis(&tok.Item{Text: "emphasis"}))
+	p.Append(NewText(&tok.Item{Text: ", "}))
+	p.Append(NewInlineStrong(&tok.Item{Text: "strong"}))
+	p.Append(NewText(&tok.Item{Text: " and "}))
+	p.Append(NewInlineLiteral(&tok.Item{Text: "a_b  c"}))
+	p.Append(NewText(&tok.Item{Text: "."}))
+	nodes := NodeList{section("One", section("Two", section("Three", section("Four", section("Five",
+		section("Six", p))))))}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		"\\documentclass[a4paper]{article}\n",
+		"\\begin{document}\n\n\\section{One}\\label{one}\n\n\\subsection{Two}\\label{two}\n\n" +
+			"\\subsubsection{Three}\\label{three}\n\n\\paragraph{Four}\\label{four}\n\n" +
+			"\\subparagraph{Five}\\label{five}\n\n\\subparagraph{Six}\\label{six}\n\n",
+		`Some \emph{emphasis}, \textbf{strong} and \texttt{a\_b~~c}.` + "\n\n\\end{document}\n",
+	} {
+		if !strings.Contains(string(out), expect) {
+			t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+		}
+	}
+	if strings.Contains(string(out), `\maketitle`) {
+		t.Error("expect no title")
+	}
+}
+
+func TestLaTeXRendererLists(t *testing.T) {
+	item := func(text string) Node {
+		i := NewBulletListItemNode(&tok.Item{})
+		i.Append(NewParagraphWithNodeText(&tok.Item{Text: text}))
+		return i
+	}
+	bl := NewBulletListNode(&tok.Item{})
+	bl.NodeList = NodeList{item("one"), item("two")}
+	el := &EnumListNode{Type: NodeEnumList, EnumType: enumListUpperRoman, Affix: enumAffixParenthesisSurround, Start: 3}
+	ei := NewEnumListItemNode()
+	ei.Append(NewParagraphWithNodeText(&tok.Item{Text: "three"}))
+	el.Append(ei)
+	dl := NewDefinitionList(&tok.Item{})
+	di := NewDefinitionListItem(&tok.Item{Text: "term"})
+	c := NewClassifier()
+	c.Append(NewText(&tok.Item{Text: "type"}))
+	di.Classifiers = []*ClassifierNode{c}
+	di.Definition.Append(NewParagraphWithNodeText(&tok.Item{Text: "Definition."}))
+	dl.Append(di)
+	nodes := NodeList{bl, el, dl}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "\\begin{itemize}\n\\item one\n\n\\item two\n\n\\end{itemize}\n\n" +
+		"\\begin{enumerate}[label=(\\Roman*),start=3]\n\\item three\n\n\\end{enumerate}\n\n" +
+		"\\begin{description}\n\\item[{term : \\emph{type}}]\nDefinition.\n\n\\end{description}\n\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestLaTeXRendererLiteral(t *testing.T) {
+	lb := NewLiteralBlock(&tok.Item{Text: "if x {\n    return `\\n`\n}"})
+	code := NewLiteralBlock(&tok.Item{Text: "print('%d' % 1)"})
+	code.Classes = []string{"code", "python"}
+	code.NodeList = NodeList{NewInline("print", "name")}
+	other := NewLiteralBlock(&tok.Item{Text: "x := 1"})
+	other.Classes = []string{"code", "go"}
+	nodes := NodeList{lb, code, other}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "\\begin{verbatim}\nif x {\n    return `\\n`\n}\n\\end{verbatim}\n\n" +
+		"\\begin{lstlisting}[language=Python]\nprint('%d' % 1)\n\\end{lstlisting}\n\n" +
+		"\\begin{lstlisting}\nx := 1\n\\end{lstlisting}\n\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestLaTeXRendererLiteralEnd(t *testing.T) {
+	lb := NewLiteralBlock(&tok.Item{Text: "Verbatim ends at\n\n  \\end{verbatim} 100%"})
+	nodes := NodeList{lb}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "\\begin{quote}\n{\\ttfamily \\raggedright \\noindent\nVerbatim~ends~at\\\\\n\\\\\n" +
+		"~~\\textbackslash{}end\\{verbatim\\}~100\\%\n}\n\\end{quote}\n\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	if strings.Contains(string(out), `\begin{verbatim}`) {
+		t.Error("expect no verbatim environment")
+	}
+}
+
+func TestLaTeXRendererImage(t *testing.T) {
+	img := NewImage("figures/plot_1#a%20b%.png", &tok.Item{Line: 1})
+	nodes := NodeList{img}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The URI is not a valid escaped path, so the percent signs are not decoded
+	expect := `\includegraphics{figures/plot\string_1\string#a\csname @percentchar\endcsname20b` +
+		`\csname @percentchar\endcsname.png}`
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	img.URI = "my%20plot.png"
+	out, err = LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := `\includegraphics{my plot.png}`; !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestLaTeXRendererRole(t *testing.T) {
+	p := NewParagraph()
+	p.Append(NewText(&tok.Item{Text: "A "}))
+	p.Append(NewInlineInterpretedTextRole(&tok.Item{Text: "foo_bar"}))
+	p.Append(NewText(&tok.Item{Text: " role."}))
+	nodes := NodeList{p}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := `A :foo\_bar: role.`; !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestLaTeXRendererMathAndRaw(t *testing.T) {
+	p := NewParagraph()
+	p.Append(NewMath(&tok.Item{Text: `x_1^2`}))
+	nodes := NodeList{
+		p,
+		NewMathBlock(&tok.Item{Text: `\frac{1}{2}`}),
+		NewMathBlock(&tok.Item{Text: `a &= b \\ c &= d`}),
+		NewRaw("latex", &tok.Item{Text: `\clearpage`}),
+		NewRaw("html", &tok.Item{Text: "<hr>"}),
+	}
+	var messages NodeList
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "$x_1^2$\n\n\\begin{equation*}\n\\frac{1}{2}\n\\end{equation*}\n\n" +
+		"\\begin{align*}\na &= b \\\\ c &= d\n\\end{align*}\n\n\\clearpage\n\\end{document}"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	if strings.Contains(string(out), "<hr>") {
+		t.Errorf("expect no html raw content in output, got\n%s", out)
+	}
+}
+
+func TestLaTeXRendererTable(t *testing.T) {
+	cell := func(text string) *TableEntryNode {
+		p := NewParagraph()
+		p.Append(NewText(&tok.Item{Text: text}))
+		return NewTableEntry(NodeList{p})
+	}
+	table := NewTable(&tok.Item{})
+	table.Title = NewTitleNodeWithText(&tok.Item{Text: "Sizes & Prices"})
+	table.ColumnWidths = []int{1, 3}
+	table.StubColumns = 1
+	table.Append(NewTableHead(NewTableRow(cell("a"), cell("b"))))
+	table.Append(NewTableBody(NewTableRow(cell("c"), NewTableEntry(nil))))
+	var messages NodeList
+	nodes := NodeList{table}
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &messages, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "\\begin{table}[htbp]\n\\centering\n\\caption{Sizes \\& Prices}\n" +
+		"\\begin{tabular}{|p{0.225\\linewidth}|p{0.675\\linewidth}|}\n\\hline\n" +
+		"\\textbf{a} & \\textbf{b} \\\\\n\\hline\n\\textbf{c} &  \\\\\n\\hline\n\\end{tabular}\n\\end{table}\n\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+}
+
+func TestLaTeXRendererSystemMessages(t *testing.T) {
+	para := func(text string, line int) *ParagraphNode {
+		p := NewParagraphWithNodeText(&tok.Item{Text: text})
+		p.Span = Span{Line: line, EndLine: line}
+		return p
+	}
+	pm := messages.NewParserMessage(messages.SectionWarningShortUnderline)
+	warning := NewSystemMessage(pm, 2)
+	info := NewSystemMessage(messages.NewParserMessage(messages.ListInfoEnumListStartNotOrdinal), 2)
+	late := NewSystemMessage(pm, 9)
+	msgs := NodeList{late, warning, info}
+	nodes := NodeList{para("First", 1), para("Second", 3)}
+	out, err := LaTeXRenderer(testutil.LoggerConfig, &msgs, &nodes).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	note := func(line int) string {
+		return fmt.Sprintf(`\marginnote{\footnotesize\textbf{System Message: WARNING/2} (line %d): %s}`+"\n", line,
+			latexEscape(pm.Message()))
+	}
+	expect := "First\n\n" + note(2) + "Second\n\n" + note(9) + "\\end{document}"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+	if strings.Contains(string(out), "INFO") {
+		t.Errorf("expect no info messages, got\n%s", out)
+	}
+}
+
+func TestLaTeXRendererTemplate(t *testing.T) {
+	var messages NodeList
+	d := NewDocument()
+	d.Title = NewTitleNodeWithText(&tok.Item{Text: "Fish & Chips"})
+	d.Subtitle = NewTitleNodeWithText(&tok.Item{Text: "A Recipe"})
+	d.Append(NewParagraphWithNodeText(&tok.Item{Text: "Text"}))
+	keywords := NewMeta("fish, chips", 1)
+	keywords.Name = "keywords"
+	d.Append(keywords)
+	out, err := LaTeXDocumentRenderer(testutil.LoggerConfig, &messages, d).Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "\\hypersetup{pdftitle={Fish \\& Chips},pdfkeywords={fish, chips}}\n" +
+		"\\title{Fish \\& Chips\\\\\n\\large{A Recipe}}\n\\author{}\n\\date{}\n\n" +
+		"\\begin{document}\n\\maketitle\n\nText\n\n\\end{document}\n"
+	if !strings.Contains(string(out), expect) {
+		t.Errorf("expect output to contain\n%s\ngot\n%s", expect, out)
+	}
+
+	l := LaTeXDocumentRenderer(testutil.LoggerConfig, &messages, d).(LaTeX)
+	l.Template = "\\documentclass{report}\n\\title{ {{- .Title}}}\n{{.Body}}"
+	out, err = l.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expect := "\\documentclass{report}\n\\title{Fish \\& Chips}\nText\n\n"; string(out) != expect {
+		t.Errorf("got\n%s\nexpect\n%s", out, expect)
+	}
+	l.Template = "{{.Missing}}"
+	if _, err := l.Bytes(); err == nil {
+		t.Error("expect an error executing a template with an unknown field")
+	}
+}
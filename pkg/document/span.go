@@ -0,0 +1,280 @@
+package document
+
+import tok "github.com/demizer/go-rst/pkg/token"
+
+// Span is the location of a node in the input. Offset and EndOffset are byte offsets into the input from 0, Line and EndLine
+// are counted from 1, and Column and EndColumn are counted in runes from 1. The end of the span is the position after the
+// last character of the node. The zero Span is the location of a node that was not found in the input, such as a node
+// created by a transform.
+//
+// The input is the source text of the lexer: the document decoded to UTF-8 without a byte order mark, with "\r\n" and "\r"
+// line endings converted to "\n", converted to NFC, and with the unicode literals "\uXXXX" and "\xXX" replaced. Tabs are not
+// expanded, so the lines and columns of a span are those of the source file. The offsets match the bytes of the file only if
+// it is UTF-8 without a byte order mark, uses "\n" line endings, and contains no unicode literals or decomposed characters.
+type Span struct {
+	Offset    int `json:"offset"`
+	EndOffset int `json:"endOffset"`
+	Line      int `json:"line"`
+	Column    int `json:"column"`
+	EndLine   int `json:"endLine"`
+	EndColumn int `json:"endColumn"`
+}
+
+// NewSpan returns the Span of the item i. The zero Span is returned if i was not located in the input.
+func NewSpan(i *tok.Item) Span {
+	if i == nil || i.Column == 0 {
+		return Span{}
+	}
+	return Span{
+		Offset:    i.Offset,
+		EndOffset: i.EndOffset,
+		Line:      i.Line,
+		Column:    i.Column,
+		EndLine:   i.EndLine,
+		EndColumn: i.EndColumn,
+	}
+}
+
+// IsZero returns true if s is the zero Span.
+func (s Span) IsZero() bool { return s == Span{} }
+
+// Contains returns true if the byte offset offset is within s.
+func (s Span) Contains(offset int) bool {
+	return !s.IsZero() && s.Offset <= offset && offset < s.EndOffset
+}
+
+// Extend extends s to include o. If s is the zero Span, it is set to o. Extending by the zero Span does not change s.
+func (s *Span) Extend(o Span) {
+	switch {
+	case o.IsZero():
+		return
+	case s.IsZero():
+		*s = o
+		return
+	}
+	if o.Offset < s.Offset {
+		s.Offset, s.Line, s.Column = o.Offset, o.Line, o.Column
+	}
+	if o.EndOffset > s.EndOffset {
+		s.EndOffset, s.EndLine, s.EndColumn = o.EndOffset, o.EndLine, o.EndColumn
+	}
+}
+
+// NodeSpan returns a pointer to the Span of n. Nil is returned if n is nil or not a node of this package.
+func NodeSpan(n Node) *Span {
+	switch t := n.(type) {
+	case *SectionNode:
+		return &t.Span
+	case *TitleNode:
+		return &t.Span
+	case *AdornmentNode:
+		return &t.Span
+	case *TextNode:
+		return &t.Span
+	case *ParagraphNode:
+		return &t.Span
+	case *InlineEmphasisNode:
+		return &t.Span
+	case *InlineStrongNode:
+		return &t.Span
+	case *InlineLiteralNode:
+		return &t.Span
+	case *InlineNode:
+		return &t.Span
+	case *InlineInterpretedText:
+		return &t.Span
+	case *InlineInterpretedTextRole:
+		return &t.Span
+	case *BlockQuoteNode:
+		return &t.Span
+	case *AttributionNode:
+		return &t.Span
+	case *SystemMessagesNode:
+		return &t.Span
+	case *SystemMessageNode:
+		return &t.Span
+	case *LiteralBlockNode:
+		return &t.Span
+	case *TransitionNode:
+		return &t.Span
+	case *CommentNode:
+		return &t.Span
+	case *BulletListNode:
+		return &t.Span
+	case *BulletListItemNode:
+		return &t.Span
+	case *EnumListNode:
+		return &t.Span
+	case *EnumListItemNode:
+		return &t.Span
+	case *DefinitionListNode:
+		return &t.Span
+	case *DefinitionListItemNode:
+		return &t.Span
+	case *DefinitionTermNode:
+		return &t.Span
+	case *ClassifierNode:
+		return &t.Span
+	case *DefinitionNode:
+		return &t.Span
+	case *AdmonitionNode:
+		return &t.Span
+	case *ImageNode:
+		return &t.Span
+	case *FigureNode:
+		return &t.Span
+	case *CaptionNode:
+		return &t.Span
+	case *LegendNode:
+		return &t.Span
+	case *TopicNode:
+		return &t.Span
+	case *ReferenceNode:
+		return &t.Span
+	case *GeneratedNode:
+		return &t.Span
+	case *RawNode:
+		return &t.Span
+	case *MathNode:
+		return &t.Span
+	case *MathBlockNode:
+		return &t.Span
+	case *TableNode:
+		return &t.Span
+	case *TableHeadNode:
+		return &t.Span
+	case *TableBodyNode:
+		return &t.Span
+	case *TableRowNode:
+		return &t.Span
+	case *TableEntryNode:
+		return &t.Span
+	case *SubscriptNode:
+		return &t.Span
+	case *SuperscriptNode:
+		return &t.Span
+	case *TitleReferenceNode:
+		return &t.Span
+	case *AbbreviationNode:
+		return &t.Span
+	case *PendingNode:
+		return &t.Span
+	case *MetaNode:
+		return &t.Span
+	case *DocumentTitleNode:
+		return &t.Span
+	case *HyperlinkTargetNode:
+		return &t.Span
+	case *DocumentNode:
+		return &t.Span
+	}
+	return nil
+}
+
+// spanChildren returns the nodes contained in n, including the titles, terms and other parts of n that are not in its
+// NodeList.
+func spanChildren(n Node) []Node {
+	var nodes []Node
+	add := func(c ...Node) {
+		for _, v := range c {
+			if v != nil && NodeSpan(v) != nil {
+				nodes = append(nodes, v)
+			}
+		}
+	}
+	switch t := n.(type) {
+	case *SectionNode:
+		if t.Title != nil {
+			add(t.Title)
+		}
+		if t.OverLine != nil {
+			add(t.OverLine)
+		}
+		if t.UnderLine != nil {
+			add(t.UnderLine)
+		}
+	case *DefinitionListItemNode:
+		if t.Term != nil {
+			add(t.Term)
+		}
+		for _, c := range t.Classifiers {
+			add(c)
+		}
+		if t.Definition != nil {
+			add(t.Definition)
+		}
+		return nodes
+	case *AdmonitionNode:
+		if t.Title != nil {
+			add(t.Title)
+		}
+	case *FigureNode:
+		if t.Image != nil {
+			add(t.Image)
+		}
+		if t.Caption != nil {
+			add(t.Caption)
+		}
+		if t.Legend != nil {
+			add(t.Legend)
+		}
+		return nodes
+	case *TopicNode:
+		if t.Title != nil {
+			add(t.Title)
+		}
+	case *TableNode:
+		if t.Title != nil {
+			add(t.Title)
+		}
+	case *DocumentNode:
+		if t.Title != nil {
+			add(t.Title)
+		}
+		if t.Subtitle != nil {
+			add(t.Subtitle)
+		}
+	}
+	if c := nodeList(n); c != nil {
+		add(*c...)
+	}
+	return nodes
+}
+
+// nodeList returns a pointer to the NodeList of n. Nil is returned if n does not have a NodeList.
+func nodeList(n Node) *NodeList {
+	switch t := n.(type) {
+	case *TitleNode:
+		return &t.NodeList
+	case *InlineLiteralNode:
+		return &t.NodeList
+	case *InlineInterpretedText:
+		return &t.NodeList
+	case *SystemMessagesNode:
+		return &t.NodeList
+	case *SystemMessageNode:
+		return &t.NodeList
+	case *DefinitionTermNode:
+		return &t.NodeList
+	case *ClassifierNode:
+		return &t.NodeList
+	case *CaptionNode:
+		return &t.NodeList
+	case *ReferenceNode:
+		return &t.NodeList
+	}
+	return Children(n)
+}
+
+// ExtendSpans extends the span of n and of every node contained in n to include the nodes they contain. Nodes without a
+// span of their own, such as paragraphs and list items, are given the span of their contents.
+func ExtendSpans(n Node) {
+	s := NodeSpan(n)
+	if s == nil {
+		return
+	}
+	for _, c := range spanChildren(n) {
+		ExtendSpans(c)
+		s.Extend(*NodeSpan(c))
+	}
+}
@@ -0,0 +1,63 @@
+package document
+
+import (
+	"testing"
+
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func TestSpanExtend(t *testing.T) {
+	a := Span{Offset: 4, EndOffset: 10, Line: 1, Column: 5, EndLine: 1, EndColumn: 11}
+	b := Span{Offset: 12, EndOffset: 20, Line: 2, Column: 1, EndLine: 2, EndColumn: 9}
+	s := Span{}
+	s.Extend(a)
+	if s != a {
+		t.Errorf("extending the zero span: got %+v, expect %+v", s, a)
+	}
+	s.Extend(Span{})
+	if s != a {
+		t.Errorf("extending by the zero span: got %+v, expect %+v", s, a)
+	}
+	s.Extend(b)
+	expect := Span{Offset: 4, EndOffset: 20, Line: 1, Column: 5, EndLine: 2, EndColumn: 9}
+	if s != expect {
+		t.Errorf("got %+v, expect %+v", s, expect)
+	}
+	if !s.Contains(4) || !s.Contains(19) || s.Contains(20) || (Span{}).Contains(0) {
+		t.Errorf("Contains does not match the span %+v", s)
+	}
+}
+
+func TestExtendSpans(t *testing.T) {
+	one := NewText(&tok.Item{Text: "one", Line: 1, StartPosition: 1, Offset: 0, EndOffset: 3, Column: 1, EndLine: 1,
+		EndColumn: 4})
+	two := NewText(&tok.Item{Text: "two", Line: 3, StartPosition: 3, Offset: 9, EndOffset: 12, Column: 3, EndLine: 3,
+		EndColumn: 6})
+	p1, p2 := NewParagraph(), NewParagraph()
+	p1.NodeList.Append(one)
+	p2.NodeList.Append(two)
+	bq := NewBlockQuote(&tok.Item{Line: 3, StartPosition: 1, Offset: 7, EndOffset: 7, Column: 1, EndLine: 3,
+		EndColumn: 1})
+	bq.NodeList.Append(p2)
+	d := NewDocument()
+	d.NodeList.Append(p1)
+	d.NodeList.Append(bq)
+
+	ExtendSpans(d)
+	tests := []struct {
+		node   Node
+		expect Span
+	}{
+		{p1, Span{Offset: 0, EndOffset: 3, Line: 1, Column: 1, EndLine: 1, EndColumn: 4}},
+		{bq, Span{Offset: 7, EndOffset: 12, Line: 3, Column: 1, EndLine: 3, EndColumn: 6}},
+		{d, Span{Offset: 0, EndOffset: 12, Line: 1, Column: 1, EndLine: 3, EndColumn: 6}},
+	}
+	for _, tt := range tests {
+		if s := NodeSpan(tt.node); *s != tt.expect {
+			t.Errorf("%s: got span %+v, expect %+v", tt.node.NodeType(), *s, tt.expect)
+		}
+	}
+	if NewText(&tok.Item{Text: "generated"}).Span != (Span{}) {
+		t.Errorf("expect the zero span for a node from an item that was not located")
+	}
+}
@@ -0,0 +1,156 @@
+// Package highlight splits source code into classified tokens for syntax highlighting. It is used by the code directive and
+// the code role to highlight literal text without external tools.
+package highlight
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Class is the classification of a token. The class names are the long token names used by Pygments, which docutils uses
+// as the class attributes of highlighted code. A class can contain several space separated names, i.e., "literal string".
+type Class string
+
+const (
+	Text            Class = ""
+	Comment         Class = "comment"
+	Keyword         Class = "keyword"
+	KeywordConstant Class = "keyword constant"
+	KeywordType     Class = "keyword type"
+	Name            Class = "name"
+	NameBuiltin     Class = "name builtin"
+	NameTag         Class = "name tag"
+	NameVariable    Class = "name variable"
+	String          Class = "literal string"
+	Number          Class = "literal number"
+	Operator        Class = "operator"
+	Punctuation     Class = "punctuation"
+)
+
+// Token is a piece of highlighted code.
+type Token struct {
+	Class Class
+	Text  string
+}
+
+// ErrUnsupportedLanguage is returned by a Highlighter if it cannot analyze the language.
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// Highlighter splits code written in language into tokens. The text of the returned tokens concatenated is equal to code.
+// ErrUnsupportedLanguage is returned if the Highlighter does not know the language.
+type Highlighter interface {
+	Highlight(language, code string) ([]Token, error)
+}
+
+// LexFunc splits code into tokens.
+type LexFunc func(code string) []Token
+
+var (
+	lexers   = make(map[string]LexFunc) // The lexers used by Default by language name
+	lexersMu sync.RWMutex               // Guards lexers, Register can be called while documents are parsed
+)
+
+// Register adds a lexer to the Default highlighter for the language names. Names are case insensitive. Registering a name
+// that already exists replaces the lexer. Register is safe to call while Default is used.
+func Register(lex LexFunc, names ...string) {
+	lexersMu.Lock()
+	defer lexersMu.Unlock()
+	for _, name := range names {
+		lexers[strings.ToLower(name)] = lex
+	}
+}
+
+// Default is the Highlighter that uses the lexers added with Register. Lexers for Go, shell, JSON, and YAML are built in.
+var Default Highlighter = builtin{}
+
+type builtin struct{}
+
+// Highlight satisfies the Highlighter interface.
+func (builtin) Highlight(language, code string) ([]Token, error) {
+	lexersMu.RLock()
+	lex, ok := lexers[strings.ToLower(language)]
+	lexersMu.RUnlock()
+	if !ok {
+		return nil, ErrUnsupportedLanguage
+	}
+	return lex(code), nil
+}
+
+// rule matches a token at the start of the remaining input. If classify is set, it is used to choose the class of the
+// matched text, otherwise class is used. Rules with lineStart set only match at the beginning of a line.
+type rule struct {
+	re        *regexp.Regexp
+	class     Class
+	classify  func(match, rest string) Class
+	lineStart bool
+}
+
+func newRule(expr string, class Class) rule {
+	return rule{re: regexp.MustCompile(`^(?:` + expr + `)`), class: class}
+}
+
+func classifyRule(expr string, classify func(match, rest string) Class) rule {
+	r := newRule(expr, Text)
+	r.classify = classify
+	return r
+}
+
+func lineStartRule(expr string, class Class) rule {
+	r := newRule(expr, class)
+	r.lineStart = true
+	return r
+}
+
+// lexRules returns a LexFunc that tokenizes code using rules. At each position the first matching rule is used. Text not
+// matched by any rule is emitted one rune at a time as Text. Adjacent tokens with the same class are merged.
+func lexRules(rules []rule) LexFunc {
+	return func(code string) []Token {
+		var toks []Token
+		add := func(c Class, text string) {
+			if n := len(toks); n > 0 && toks[n-1].Class == c {
+				toks[n-1].Text += text
+				return
+			}
+			toks = append(toks, Token{Class: c, Text: text})
+		}
+		for pos := 0; pos < len(code); {
+			rest := code[pos:]
+			matched := false
+			for _, r := range rules {
+				if r.lineStart && pos > 0 && code[pos-1] != '\n' {
+					continue
+				}
+				m := r.re.FindString(rest)
+				if m == "" {
+					continue
+				}
+				c := r.class
+				if r.classify != nil {
+					c = r.classify(m, rest[len(m):])
+				}
+				add(c, m)
+				pos += len(m)
+				matched = true
+				break
+			}
+			if !matched {
+				_, w := utf8.DecodeRuneInString(rest)
+				add(Text, rest[:w])
+				pos += w
+			}
+		}
+		return toks
+	}
+}
+
+// words returns a set containing the space separated words in s.
+func words(s string) map[string]bool {
+	m := make(map[string]bool)
+	for _, w := range strings.Fields(s) {
+		m[w] = true
+	}
+	return m
+}
@@ -0,0 +1,90 @@
+package highlight
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		lang, code string
+		expect     []Token
+	}{
+		{"go", "func main() {\n\treturn nil // done\n}", []Token{
+			{Keyword, "func"}, {Text, " "}, {Name, "main"}, {Punctuation, "()"}, {Text, " "}, {Punctuation, "{"},
+			{Text, "\n\t"}, {Keyword, "return"}, {Text, " "}, {KeywordConstant, "nil"}, {Text, " "},
+			{Comment, "// done"}, {Text, "\n"}, {Punctuation, "}"},
+		}},
+		{"Go", `x := len("a\"b") + 0x1F`, []Token{
+			{Name, "x"}, {Text, " "}, {Operator, ":="}, {Text, " "}, {NameBuiltin, "len"}, {Punctuation, "("},
+			{String, `"a\"b"`}, {Punctuation, ")"}, {Text, " "}, {Operator, "+"}, {Text, " "}, {Number, "0x1F"},
+		}},
+		{"bash", "if [ -n \"$HOME\" ]; then echo $HOME # home\nfi", []Token{
+			{Keyword, "if"}, {Text, " "}, {Punctuation, "["}, {Text, " -n "}, {String, `"$HOME"`}, {Text, " "},
+			{Punctuation, "]"}, {Operator, ";"}, {Text, " "}, {Keyword, "then"}, {Text, " "}, {NameBuiltin, "echo"},
+			{Text, " "}, {NameVariable, "$HOME"}, {Text, " "}, {Comment, "# home"}, {Text, "\n"}, {Keyword, "fi"},
+		}},
+		{"json", `{"a": [1, true, "b"]}`, []Token{
+			{Punctuation, "{"}, {NameTag, `"a"`}, {Punctuation, ":"}, {Text, " "}, {Punctuation, "["},
+			{Number, "1"}, {Punctuation, ","}, {Text, " "}, {KeywordConstant, "true"}, {Punctuation, ","},
+			{Text, " "}, {String, `"b"`}, {Punctuation, "]}"},
+		}},
+		{"yaml", "---\nname: go-rst # comment\nlist:\n  - 42\n  - yes", []Token{
+			{Punctuation, "---"}, {Text, "\n"}, {NameTag, "name"}, {Punctuation, ":"}, {Text, " "},
+			{String, "go-rst "}, {Comment, "# comment"}, {Text, "\n"}, {NameTag, "list"}, {Punctuation, ":"},
+			{Text, "\n  "}, {Punctuation, "-"}, {Text, " "}, {Number, "42"}, {Text, "\n  "}, {Punctuation, "-"},
+			{Text, " "}, {KeywordConstant, "yes"},
+		}},
+	}
+	for _, tt := range tests {
+		toks, err := Default.Highlight(tt.lang, tt.code)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tt.lang, err)
+		}
+		var text strings.Builder
+		for _, tok := range toks {
+			text.WriteString(tok.Text)
+		}
+		if text.String() != tt.code {
+			t.Errorf("%s: token text %q does not match input %q", tt.lang, text.String(), tt.code)
+		}
+		if len(toks) != len(tt.expect) {
+			t.Errorf("%s: got %d tokens, expect %d\ngot: %q", tt.lang, len(toks), len(tt.expect), toks)
+			continue
+		}
+		for i := range toks {
+			if toks[i] != tt.expect[i] {
+				t.Errorf("%s: token %d: got %q, expect %q", tt.lang, i, toks[i], tt.expect[i])
+			}
+		}
+	}
+}
+
+func TestHighlightUnsupportedLanguage(t *testing.T) {
+	if _, err := Default.Highlight("cobol", "DISPLAY 'HELLO'."); err != ErrUnsupportedLanguage {
+		t.Errorf("expect ErrUnsupportedLanguage, got %v", err)
+	}
+}
+
+func TestRegisterWhileHighlighting(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			Register(func(code string) []Token { return []Token{{Text: code}} }, "plain")
+		}()
+		go func() {
+			defer wg.Done()
+			if _, err := Default.Highlight("go", "x := 1"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	toks, err := Default.Highlight("PLAIN", "text")
+	if err != nil || len(toks) != 1 || toks[0].Text != "text" {
+		t.Errorf("got %v, %v, expect the registered lexer", toks, err)
+	}
+}
@@ -0,0 +1,125 @@
+package highlight
+
+import "strings"
+
+var (
+	goKeywords = words(`break case chan const continue default defer else fallthrough for func go goto if import interface
+		map package range return select struct switch type var`)
+	goTypes = words(`any bool byte complex64 complex128 error float32 float64 int int8 int16 int32 int64 rune string uint
+		uint8 uint16 uint32 uint64 uintptr`)
+	goConstants = words(`true false nil iota`)
+	goBuiltins  = words(`append cap clear close complex copy delete imag len make max min new panic print println real
+		recover`)
+)
+
+var goRules = []rule{
+	newRule(`\s+`, Text),
+	newRule(`//[^\n]*|/\*(?s:.*?)\*/`, Comment),
+	newRule("`[^`]*`"+`|"(?:\\.|[^"\\\n])*"|'(?:\\.|[^'\\\n])+'`, String),
+	newRule(`0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO][0-7_]+|(?:\d[\d_]*(?:\.\d*)?|\.\d+)(?:[eE][+-]?\d+)?i?`, Number),
+	classifyRule(`[\pL_][\pL\pN_]*`, func(m, _ string) Class {
+		switch {
+		case goKeywords[m]:
+			return Keyword
+		case goTypes[m]:
+			return KeywordType
+		case goConstants[m]:
+			return KeywordConstant
+		case goBuiltins[m]:
+			return NameBuiltin
+		}
+		return Name
+	}),
+	newRule(`[-+*/%&|^<>=!:.~]+`, Operator),
+	newRule(`[(){}\[\],;]`, Punctuation),
+}
+
+var (
+	shellKeywords = words(`if then else elif fi for while until do done case esac in function select return`)
+	shellBuiltins = words(`alias bg break cd continue echo eval exec exit export false fg getopts hash jobs kill let local
+		printf pwd read readonly set shift source test trap true type ulimit umask unalias unset wait`)
+)
+
+var shellRules = []rule{
+	newRule(`\s+`, Text),
+	newRule(`#[^\n]*`, Comment),
+	newRule(`"(?:\\.|[^"\\])*"|'[^']*'`, String),
+	newRule(`\$\{[^}\n]*\}|\$[\pL_][\pL\pN_]*|\$[0-9@#?$!*-]`, NameVariable),
+	classifyRule(`[\pL\pN_./@%+,:~-][\pL\pN_./@%+,:~=#-]*`, func(m, _ string) Class {
+		switch {
+		case shellKeywords[m]:
+			return Keyword
+		case shellBuiltins[m]:
+			return NameBuiltin
+		case strings.Trim(m, "0123456789") == "":
+			return Number
+		}
+		return Text
+	}),
+	newRule(`&&|\|\||[|&;<>]+|=`, Operator),
+	newRule(`[(){}\[\]\\]`, Punctuation),
+}
+
+var jsonRules = []rule{
+	newRule(`\s+`, Text),
+	classifyRule(`"(?:\\.|[^"\\\n])*"`, func(_, rest string) Class {
+		if strings.HasPrefix(strings.TrimLeft(rest, " \t\r\n"), ":") {
+			return NameTag
+		}
+		return String
+	}),
+	newRule(`-?(?:0|[1-9]\d*)(?:\.\d+)?(?:[eE][+-]?\d+)?`, Number),
+	newRule(`true|false|null`, KeywordConstant),
+	newRule(`[{}\[\],:]`, Punctuation),
+}
+
+var yamlConstants = words(`true false yes no on off null ~ True False Yes No On Off Null TRUE FALSE YES NO ON OFF NULL`)
+
+var yamlRules = []rule{
+	lineStartRule(`---|\.\.\.`, Punctuation),
+	newRule(`\s+`, Text),
+	newRule(`#[^\n]*`, Comment),
+	newRule(`"(?:\\.|[^"\\])*"|'(?:''|[^'])*'`, String),
+	newRule(`[&*][\pL\pN_-]+`, NameVariable),
+	newRule(`![^\s]*`, KeywordType),
+	newRule(`[|>][-+]?\d*`, Punctuation),
+	classifyRule(`(?:[^\s#:,\[\]{}-]|-[^\s])(?:[^\n#:,\[\]{}]|:[^\s])*`, func(m, rest string) Class {
+		m = strings.TrimRight(m, " \t")
+		switch {
+		case strings.HasPrefix(rest, ":") && (len(rest) == 1 || strings.ContainsAny(rest[1:2], " \t\r\n")):
+			return NameTag
+		case yamlConstants[m]:
+			return KeywordConstant
+		case isYAMLNumber(m):
+			return Number
+		}
+		return String
+	}),
+	newRule(`[-:,\[\]{}?]`, Punctuation),
+}
+
+// isYAMLNumber returns true if s is an integer or float scalar.
+func isYAMLNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	dot := false
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+		case r == '.' && !dot && i > 0:
+			dot = true
+		default:
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+func init() {
+	Register(lexRules(goRules), "go", "golang")
+	Register(lexRules(shellRules), "bash", "sh", "shell", "zsh")
+	Register(lexRules(jsonRules), "json")
+	Register(lexRules(yamlRules), "yaml", "yml")
+}
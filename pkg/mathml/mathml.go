@@ -0,0 +1,513 @@
+// Package mathml converts LaTeX math to MathML. It is used by the HTML renderer to output the content of math directives
+// and math roles without external tools or client side scripts. A useful subset of LaTeX is supported: fractions, roots,
+// sub and superscripts, Greek letters, operators, relations, arrows, accents, font commands, delimiters, and matrix
+// environments.
+package mathml
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Convert returns the MathML math element for the LaTeX math in tex. If block is true, the math is displayed as a block.
+// An error is returned if tex contains unsupported commands or unbalanced groups.
+func Convert(tex string, block bool) (string, error) {
+	toks, err := lex(tex)
+	if err != nil {
+		return "", err
+	}
+	p := &parser{toks: toks, display: block}
+	els, err := p.expr()
+	if err != nil {
+		return "", err
+	}
+	if t := p.peek(); t != nil {
+		return "", fmt.Errorf("unexpected %q", t.text)
+	}
+	var b strings.Builder
+	b.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML"`)
+	if block {
+		b.WriteString(` display="block"`)
+	}
+	b.WriteString(">")
+	b.WriteString(mrow(els))
+	b.WriteString("</math>")
+	return b.String(), nil
+}
+
+type tokenType int
+
+const (
+	tokCommand tokenType = iota // A command without the backslash, i.e., "frac" or "{"
+	tokLetter
+	tokNumber
+	tokChar
+	tokOpen
+	tokClose
+	tokSup
+	tokSub
+	tokAmp
+	tokText // The braced argument of a text command
+)
+
+type token struct {
+	typ  tokenType
+	text string
+}
+
+// lex splits tex into tokens. White space is not significant in math and is dropped, except in the arguments of text
+// commands.
+func lex(tex string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(tex); {
+		r, w := utf8.DecodeRuneInString(tex[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += w
+		case r == '\\':
+			i++
+			if i >= len(tex) {
+				return nil, fmt.Errorf("incomplete command at end of math")
+			}
+			j := i
+			for j < len(tex) && isASCIILetter(tex[j]) {
+				j++
+			}
+			if j == i {
+				_, w := utf8.DecodeRuneInString(tex[i:])
+				j = i + w
+			}
+			name := tex[i:j]
+			toks = append(toks, token{tokCommand, name})
+			i = j
+			if _, ok := textCommands[name]; ok {
+				for i < len(tex) && tex[i] == ' ' {
+					i++
+				}
+				if i >= len(tex) || tex[i] != '{' {
+					return nil, fmt.Errorf("missing argument for \\%s", name)
+				}
+				end := strings.IndexByte(tex[i:], '}')
+				if end < 0 {
+					return nil, fmt.Errorf("missing } for \\%s", name)
+				}
+				toks = append(toks, token{tokText, tex[i+1 : i+end]})
+				i += end + 1
+			}
+		case r >= '0' && r <= '9' || r == '.' && i+1 < len(tex) && tex[i+1] >= '0' && tex[i+1] <= '9':
+			j := i
+			for j < len(tex) && (tex[j] >= '0' && tex[j] <= '9' || tex[j] == '.') {
+				j++
+			}
+			toks = append(toks, token{tokNumber, tex[i:j]})
+			i = j
+		default:
+			t := token{tokChar, string(r)}
+			switch {
+			case r == '{':
+				t.typ = tokOpen
+			case r == '}':
+				t.typ = tokClose
+			case r == '^':
+				t.typ = tokSup
+			case r == '_':
+				t.typ = tokSub
+			case r == '&':
+				t.typ = tokAmp
+			case unicode.IsLetter(r):
+				t.typ = tokLetter
+			}
+			toks = append(toks, t)
+			i += w
+		}
+	}
+	return toks, nil
+}
+
+func isASCIILetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+type parser struct {
+	toks     []token
+	pos      int
+	display  bool
+	variant  string // The mathvariant of identifiers set by a font command
+	optional int    // The nesting depth of optional arguments
+}
+
+func (p *parser) peek() *token {
+	if p.pos < len(p.toks) {
+		return &p.toks[p.pos]
+	}
+	return nil
+}
+
+func (p *parser) next() *token {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+// isCommand returns true if t is the command name.
+func (t *token) isCommand(name string) bool { return t != nil && t.typ == tokCommand && t.text == name }
+
+// expr parses terms until the end of a group, a cell or row of a matrix, or a \right delimiter.
+func (p *parser) expr() ([]string, error) {
+	var els []string
+	for {
+		t := p.peek()
+		if t == nil || t.typ == tokClose || t.typ == tokAmp || t.isCommand("\\") || t.isCommand("end") ||
+			t.isCommand("right") || (t.typ == tokChar && t.text == "]" && p.inOptional()) {
+			return els, nil
+		}
+		el, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		els = append(els, el)
+	}
+}
+
+// inOptional returns true while parsing the optional argument of \sqrt, which ends at a closing bracket.
+func (p *parser) inOptional() bool { return p.optional > 0 }
+
+// term parses an atom followed by optional sub and superscripts.
+func (p *parser) term() (string, error) {
+	base, limits, err := p.atom()
+	if err != nil {
+		return "", err
+	}
+	var sub, sup, primes string
+	for {
+		t := p.peek()
+		if t == nil {
+			break
+		}
+		if t.typ == tokChar && t.text == "'" {
+			p.next()
+			primes += "<mo>′</mo>"
+			continue
+		}
+		if t.typ != tokSub && t.typ != tokSup {
+			break
+		}
+		p.next()
+		arg, err := p.argument()
+		if err != nil {
+			return "", err
+		}
+		if t.typ == tokSub {
+			if sub != "" {
+				return "", fmt.Errorf("double subscript")
+			}
+			sub = arg
+		} else {
+			if sup != "" {
+				return "", fmt.Errorf("double superscript")
+			}
+			sup = arg
+		}
+	}
+	if primes != "" && sup != "" {
+		sup = "<mrow>" + primes + sup + "</mrow>"
+	} else if primes != "" {
+		sup = primes
+		if strings.Count(primes, "<mo>") > 1 {
+			sup = "<mrow>" + primes + "</mrow>"
+		}
+	}
+	under, over := "msub", "msup"
+	both := "msubsup"
+	if limits && p.display {
+		under, over, both = "munder", "mover", "munderover"
+	}
+	switch {
+	case sub != "" && sup != "":
+		return "<" + both + ">" + base + sub + sup + "</" + both + ">", nil
+	case sub != "":
+		return "<" + under + ">" + base + sub + "</" + under + ">", nil
+	case sup != "":
+		return "<" + over + ">" + base + sup + "</" + over + ">", nil
+	}
+	return base, nil
+}
+
+// argument parses the argument of a command or script, which is a group or a single atom.
+func (p *parser) argument() (string, error) {
+	t := p.peek()
+	if t == nil {
+		return "", fmt.Errorf("missing argument at end of math")
+	}
+	if t.typ == tokOpen {
+		return p.group()
+	}
+	el, _, err := p.atom()
+	return el, err
+}
+
+// group parses a group in braces.
+func (p *parser) group() (string, error) {
+	if t := p.next(); t == nil || t.typ != tokOpen {
+		return "", fmt.Errorf("missing {")
+	}
+	els, err := p.expr()
+	if err != nil {
+		return "", err
+	}
+	if t := p.next(); t == nil || t.typ != tokClose {
+		return "", fmt.Errorf("missing }")
+	}
+	return mrow(els), nil
+}
+
+// atom parses a single element. limits is true for operators with limits drawn above and below in display math.
+func (p *parser) atom() (el string, limits bool, err error) {
+	t := p.next()
+	switch t.typ {
+	case tokOpen:
+		p.pos--
+		el, err = p.group()
+		return
+	case tokLetter:
+		return p.identifier(t.text), false, nil
+	case tokNumber:
+		return "<mn>" + t.text + "</mn>", false, nil
+	case tokChar:
+		switch t.text {
+		case "-":
+			return "<mo>−</mo>", false, nil
+		case "*":
+			return "<mo>∗</mo>", false, nil
+		case "+", "=", "<", ">", "(", ")", "[", "]", "|", "/", ",", ";", ":", "!", "?", ".":
+			return "<mo>" + escape(t.text) + "</mo>", false, nil
+		case "~":
+			return `<mspace width="0.25em"/>`, false, nil
+		}
+		return p.identifier(t.text), false, nil
+	case tokCommand:
+		return p.command(t.text)
+	}
+	return "", false, fmt.Errorf("unexpected %q", t.text)
+}
+
+// identifier returns an mi element for text using the current font variant.
+func (p *parser) identifier(text string) string {
+	if p.variant != "" && !(p.variant == "italic" && utf8.RuneCountInString(text) == 1) {
+		return `<mi mathvariant="` + p.variant + `">` + escape(text) + "</mi>"
+	}
+	return "<mi>" + escape(text) + "</mi>"
+}
+
+// command parses a LaTeX command and its arguments.
+func (p *parser) command(name string) (string, bool, error) {
+	if s, ok := symbols[name]; ok {
+		if s.variant != "" {
+			return "<" + s.elem + ` mathvariant="` + s.variant + `">` + s.text + "</" + s.elem + ">", false, nil
+		}
+		return "<" + s.elem + ">" + s.text + "</" + s.elem + ">", false, nil
+	}
+	if s, ok := largeOperators[name]; ok {
+		return "<mo>" + s + "</mo>", true, nil
+	}
+	if s, ok := integrals[name]; ok {
+		return "<mo>" + s + "</mo>", false, nil
+	}
+	if limits, ok := functions[name]; ok {
+		return "<mi>" + name + "</mi>", limits, nil
+	}
+	if w, ok := spaces[name]; ok {
+		return `<mspace width="` + w + `"/>`, false, nil
+	}
+	if elem, ok := textCommands[name]; ok {
+		t := p.next()
+		return "<" + elem + ">" + escape(t.text) + "</" + elem + ">", false, nil
+	}
+	if v, ok := fonts[name]; ok {
+		prev := p.variant
+		p.variant = v
+		el, err := p.argument()
+		p.variant = prev
+		return el, false, err
+	}
+	if a, ok := accents[name]; ok {
+		arg, err := p.argument()
+		if err != nil {
+			return "", false, err
+		}
+		if name == "underline" {
+			return `<munder accentunder="true">` + arg + "<mo>" + a + "</mo></munder>", false, nil
+		}
+		return `<mover accent="true">` + arg + "<mo>" + a + "</mo></mover>", false, nil
+	}
+
+	switch name {
+	case "frac", "dfrac", "tfrac", "binom":
+		num, err := p.argument()
+		if err != nil {
+			return "", false, err
+		}
+		den, err := p.argument()
+		if err != nil {
+			return "", false, err
+		}
+		if name == "binom" {
+			return `<mrow><mo>(</mo><mfrac linethickness="0">` + num + den + "</mfrac><mo>)</mo></mrow>", false, nil
+		}
+		return "<mfrac>" + num + den + "</mfrac>", false, nil
+	case "sqrt":
+		var index string
+		if t := p.peek(); t != nil && t.typ == tokChar && t.text == "[" {
+			p.next()
+			p.optional++
+			els, err := p.expr()
+			p.optional--
+			if err != nil {
+				return "", false, err
+			}
+			if t := p.next(); t == nil || t.text != "]" {
+				return "", false, fmt.Errorf("missing ] for \\sqrt")
+			}
+			index = mrow(els)
+		}
+		arg, err := p.argument()
+		if err != nil {
+			return "", false, err
+		}
+		if index != "" {
+			return "<mroot>" + arg + index + "</mroot>", false, nil
+		}
+		return "<msqrt>" + arg + "</msqrt>", false, nil
+	case "left":
+		return p.fenced()
+	case "begin":
+		return p.environment()
+	}
+	return "", false, fmt.Errorf("unknown LaTeX command \\%s", name)
+}
+
+// delimiter parses the delimiter following \left or \right. A period is an empty delimiter.
+func (p *parser) delimiter(cmd string) (string, error) {
+	t := p.next()
+	if t == nil {
+		return "", fmt.Errorf("missing delimiter for \\%s", cmd)
+	}
+	switch {
+	case t.typ == tokChar && t.text == ".":
+		return "", nil
+	case t.typ == tokChar:
+		return `<mo fence="true" stretchy="true">` + escape(t.text) + "</mo>", nil
+	case t.typ == tokCommand:
+		if s, ok := symbols[t.text]; ok && s.elem == "mo" {
+			return `<mo fence="true" stretchy="true">` + s.text + "</mo>", nil
+		}
+	}
+	return "", fmt.Errorf("invalid delimiter %q for \\%s", t.text, cmd)
+}
+
+// fenced parses the content between \left and \right.
+func (p *parser) fenced() (string, bool, error) {
+	left, err := p.delimiter("left")
+	if err != nil {
+		return "", false, err
+	}
+	els, err := p.expr()
+	if err != nil {
+		return "", false, err
+	}
+	if t := p.next(); !t.isCommand("right") {
+		return "", false, fmt.Errorf("missing \\right")
+	}
+	right, err := p.delimiter("right")
+	if err != nil {
+		return "", false, err
+	}
+	return "<mrow>" + left + strings.Join(els, "") + right + "</mrow>", false, nil
+}
+
+// environmentName parses the braced name following \begin or \end.
+func (p *parser) environmentName() (string, error) {
+	if t := p.next(); t == nil || t.typ != tokOpen {
+		return "", fmt.Errorf("missing environment name")
+	}
+	var name string
+	for t := p.next(); t == nil || t.typ != tokClose; t = p.next() {
+		if t == nil {
+			return "", fmt.Errorf("missing } in environment name")
+		}
+		name += t.text
+	}
+	return name, nil
+}
+
+// environment parses a matrix environment into a table. Cells are separated by & and rows by \\.
+func (p *parser) environment() (string, bool, error) {
+	name, err := p.environmentName()
+	if err != nil {
+		return "", false, err
+	}
+	delims, ok := matrices[name]
+	if !ok {
+		return "", false, fmt.Errorf("unknown LaTeX environment %q", name)
+	}
+	var rows []string
+	var cells []string
+	for {
+		els, err := p.expr()
+		if err != nil {
+			return "", false, err
+		}
+		cells = append(cells, "<mtd>"+mrow(els)+"</mtd>")
+		t := p.next()
+		switch {
+		case t == nil:
+			return "", false, fmt.Errorf("missing \\end{%s}", name)
+		case t.typ == tokAmp:
+			continue
+		case t.isCommand("\\"):
+			rows = append(rows, "<mtr>"+strings.Join(cells, "")+"</mtr>")
+			cells = nil
+			continue
+		case t.isCommand("end"):
+			if end, err := p.environmentName(); err != nil || end != name {
+				return "", false, fmt.Errorf("\\begin{%s} ended by \\end{%s}", name, end)
+			}
+		default:
+			return "", false, fmt.Errorf("unexpected %q in %s environment", t.text, name)
+		}
+		break
+	}
+	if len(cells) > 1 || cells[0] != "<mtd></mtd>" {
+		rows = append(rows, "<mtr>"+strings.Join(cells, "")+"</mtr>")
+	}
+	table := "<mtable>"
+	if name == "cases" || name == "aligned" {
+		table = `<mtable columnalign="left">`
+	}
+	table += strings.Join(rows, "") + "</mtable>"
+	if delims[0] == "" && delims[1] == "" {
+		return table, false, nil
+	}
+	el := "<mrow>"
+	if delims[0] != "" {
+		el += `<mo fence="true">` + escape(delims[0]) + "</mo>"
+	}
+	el += table
+	if delims[1] != "" {
+		el += `<mo fence="true">` + escape(delims[1]) + "</mo>"
+	}
+	return el + "</mrow>", false, nil
+}
+
+// mrow returns the elements grouped in an mrow element. A single element is returned unchanged.
+func mrow(els []string) string {
+	if len(els) == 1 {
+		return els[0]
+	}
+	return "<mrow>" + strings.Join(els, "") + "</mrow>"
+}
+
+func escape(s string) string { return html.EscapeString(s) }
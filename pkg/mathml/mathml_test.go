@@ -0,0 +1,51 @@
+package mathml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		tex    string
+		block  bool
+		expect string
+	}{
+		{`\frac{a}{b}`, false, "<mfrac><mi>a</mi><mi>b</mi></mfrac>"},
+		{`x^2 - y_i^{n+1}`, false, "<mrow><msup><mi>x</mi><mn>2</mn></msup><mo>−</mo><msubsup><mi>y</mi><mi>i</mi>" +
+			"<mrow><mi>n</mi><mo>+</mo><mn>1</mn></mrow></msubsup></mrow>"},
+		{`\alpha \leq \Gamma`, false, `<mrow><mi>α</mi><mo>≤</mo><mi mathvariant="normal">Γ</mi></mrow>`},
+		{`\sum_{i=1}^n i`, true, "<mrow><munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi>" +
+			"</munderover><mi>i</mi></mrow>"},
+		{`\sum_{i=1}^n i`, false, "<mrow><msubsup><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi>" +
+			"</msubsup><mi>i</mi></mrow>"},
+		{`f'(x)`, false, "<mrow><msup><mi>f</mi><mo>′</mo></msup><mo>(</mo><mi>x</mi><mo>)</mo></mrow>"},
+		{`\sqrt[3]{x} < \sqrt{2}`, false, "<mrow><mroot><mi>x</mi><mn>3</mn></mroot><mo>&lt;</mo><msqrt><mn>2</mn></msqrt></mrow>"},
+		{`\begin{pmatrix} a & b \\ c & d \end{pmatrix}`, false, `<mrow><mo fence="true">(</mo><mtable>` +
+			"<mtr><mtd><mi>a</mi></mtd><mtd><mi>b</mi></mtd></mtr><mtr><mtd><mi>c</mi></mtd><mtd><mi>d</mi></mtd></mtr>" +
+			`</mtable><mo fence="true">)</mo></mrow>`},
+		{`\left( x \right.`, false, `<mrow><mo fence="true" stretchy="true">(</mo><mi>x</mi></mrow>`},
+		{`\text{if } \mathbf{v}`, false, `<mrow><mtext>if </mtext><mi mathvariant="bold">v</mi></mrow>`},
+	}
+	for _, tt := range tests {
+		got, err := Convert(tt.tex, tt.block)
+		if err != nil {
+			t.Errorf("%s: %s", tt.tex, err)
+			continue
+		}
+		if !strings.Contains(got, ">"+tt.expect+"</math>") {
+			t.Errorf("%s:\ngot    %s\nexpect %s", tt.tex, got, tt.expect)
+		}
+		if tt.block != strings.Contains(got, `display="block"`) {
+			t.Errorf("%s: got %s, expect block %t", tt.tex, got, tt.block)
+		}
+	}
+}
+
+func TestConvertError(t *testing.T) {
+	for _, tex := range []string{`\unknown`, `x_`, `{x`, `x}`, `\begin{pmatrix} a`, `\begin{foo}\end{foo}`, `a^b^c`} {
+		if got, err := Convert(tex, false); err == nil {
+			t.Errorf("%s: expect error, got %s", tex, got)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package mathml
+
+// symbol is a LaTeX command that produces a single MathML token element.
+type symbol struct {
+	elem    string // The MathML element, "mi" or "mo"
+	text    string
+	variant string // The mathvariant attribute, if any
+}
+
+// symbols maps LaTeX commands without the backslash to MathML token elements.
+var symbols = map[string]symbol{
+	// Lowercase Greek letters
+	"alpha": {"mi", "α", ""}, "beta": {"mi", "β", ""}, "gamma": {"mi", "γ", ""}, "delta": {"mi", "δ", ""},
+	"epsilon": {"mi", "ϵ", ""}, "varepsilon": {"mi", "ε", ""}, "zeta": {"mi", "ζ", ""}, "eta": {"mi", "η", ""},
+	"theta": {"mi", "θ", ""}, "vartheta": {"mi", "ϑ", ""}, "iota": {"mi", "ι", ""}, "kappa": {"mi", "κ", ""},
+	"lambda": {"mi", "λ", ""}, "mu": {"mi", "μ", ""}, "nu": {"mi", "ν", ""}, "xi": {"mi", "ξ", ""},
+	"pi": {"mi", "π", ""}, "varpi": {"mi", "ϖ", ""}, "rho": {"mi", "ρ", ""}, "varrho": {"mi", "ϱ", ""},
+	"sigma": {"mi", "σ", ""}, "varsigma": {"mi", "ς", ""}, "tau": {"mi", "τ", ""}, "upsilon": {"mi", "υ", ""},
+	"phi": {"mi", "ϕ", ""}, "varphi": {"mi", "φ", ""}, "chi": {"mi", "χ", ""}, "psi": {"mi", "ψ", ""},
+	"omega": {"mi", "ω", ""},
+
+	// Uppercase Greek letters are upright
+	"Gamma": {"mi", "Γ", "normal"}, "Delta": {"mi", "Δ", "normal"}, "Theta": {"mi", "Θ", "normal"},
+	"Lambda": {"mi", "Λ", "normal"}, "Xi": {"mi", "Ξ", "normal"}, "Pi": {"mi", "Π", "normal"},
+	"Sigma": {"mi", "Σ", "normal"}, "Upsilon": {"mi", "Υ", "normal"}, "Phi": {"mi", "Φ", "normal"},
+	"Psi": {"mi", "Ψ", "normal"}, "Omega": {"mi", "Ω", "normal"},
+
+	// Letter-like symbols
+	"infty": {"mi", "∞", ""}, "partial": {"mi", "∂", ""}, "nabla": {"mi", "∇", ""}, "emptyset": {"mi", "∅", ""},
+	"varnothing": {"mi", "∅", ""}, "hbar": {"mi", "ℏ", ""}, "ell": {"mi", "ℓ", ""}, "Re": {"mi", "ℜ", ""},
+	"Im": {"mi", "ℑ", ""}, "aleph": {"mi", "ℵ", ""},
+
+	// Binary operators
+	"pm": {"mo", "±", ""}, "mp": {"mo", "∓", ""}, "times": {"mo", "×", ""}, "div": {"mo", "÷", ""},
+	"cdot": {"mo", "⋅", ""}, "ast": {"mo", "∗", ""}, "star": {"mo", "⋆", ""}, "circ": {"mo", "∘", ""},
+	"bullet": {"mo", "∙", ""}, "oplus": {"mo", "⊕", ""}, "otimes": {"mo", "⊗", ""}, "cup": {"mo", "∪", ""},
+	"cap": {"mo", "∩", ""}, "setminus": {"mo", "∖", ""}, "wedge": {"mo", "∧", ""}, "vee": {"mo", "∨", ""},
+	"land": {"mo", "∧", ""}, "lor": {"mo", "∨", ""}, "neg": {"mo", "¬", ""}, "lnot": {"mo", "¬", ""},
+
+	// Relations
+	"leq": {"mo", "≤", ""}, "le": {"mo", "≤", ""}, "geq": {"mo", "≥", ""}, "ge": {"mo", "≥", ""},
+	"neq": {"mo", "≠", ""}, "ne": {"mo", "≠", ""}, "approx": {"mo", "≈", ""}, "equiv": {"mo", "≡", ""},
+	"sim": {"mo", "∼", ""}, "simeq": {"mo", "≃", ""}, "cong": {"mo", "≅", ""}, "propto": {"mo", "∝", ""},
+	"ll": {"mo", "≪", ""}, "gg": {"mo", "≫", ""}, "in": {"mo", "∈", ""}, "notin": {"mo", "∉", ""},
+	"ni": {"mo", "∋", ""}, "subset": {"mo", "⊂", ""}, "supset": {"mo", "⊃", ""}, "subseteq": {"mo", "⊆", ""},
+	"supseteq": {"mo", "⊇", ""}, "mid": {"mo", "∣", ""}, "parallel": {"mo", "∥", ""}, "perp": {"mo", "⊥", ""},
+	"forall": {"mo", "∀", ""}, "exists": {"mo", "∃", ""}, "angle": {"mo", "∠", ""},
+
+	// Arrows
+	"to": {"mo", "→", ""}, "rightarrow": {"mo", "→", ""}, "leftarrow": {"mo", "←", ""}, "gets": {"mo", "←", ""},
+	"Rightarrow": {"mo", "⇒", ""}, "Leftarrow": {"mo", "⇐", ""}, "leftrightarrow": {"mo", "↔", ""},
+	"Leftrightarrow": {"mo", "⇔", ""}, "implies": {"mo", "⟹", ""}, "iff": {"mo", "⟺", ""},
+	"mapsto": {"mo", "↦", ""},
+
+	// Dots and delimiters
+	"ldots": {"mo", "…", ""}, "dots": {"mo", "…", ""}, "cdots": {"mo", "⋯", ""}, "vdots": {"mo", "⋮", ""},
+	"ddots": {"mo", "⋱", ""}, "langle": {"mo", "⟨", ""}, "rangle": {"mo", "⟩", ""}, "lfloor": {"mo", "⌊", ""},
+	"rfloor": {"mo", "⌋", ""}, "lceil": {"mo", "⌈", ""}, "rceil": {"mo", "⌉", ""}, "vert": {"mo", "|", ""},
+	"Vert": {"mo", "‖", ""}, "|": {"mo", "‖", ""}, "{": {"mo", "{", ""}, "}": {"mo", "}", ""},
+	"colon": {"mo", ":", ""},
+}
+
+// largeOperators are operators with limits that are drawn above and below the operator in display math.
+var largeOperators = map[string]string{
+	"sum": "∑", "prod": "∏", "coprod": "∐", "bigcup": "⋃", "bigcap": "⋂", "bigoplus": "⨁", "bigotimes": "⨂",
+	"bigvee": "⋁", "bigwedge": "⋀",
+}
+
+// integrals are operators with limits that are always drawn as sub and superscripts.
+var integrals = map[string]string{
+	"int": "∫", "iint": "∬", "iiint": "∭", "oint": "∮",
+}
+
+// functions are named functions typeset as upright identifiers. Functions marked true have limits drawn below the name
+// in display math.
+var functions = map[string]bool{
+	"sin": false, "cos": false, "tan": false, "cot": false, "sec": false, "csc": false, "arcsin": false,
+	"arccos": false, "arctan": false, "sinh": false, "cosh": false, "tanh": false, "log": false, "ln": false,
+	"lg": false, "exp": false, "arg": false, "deg": false, "dim": false, "ker": false, "hom": false,
+	"lim": true, "max": true, "min": true, "sup": true, "inf": true, "det": true, "gcd": true, "Pr": true,
+}
+
+// spaces maps spacing commands to widths.
+var spaces = map[string]string{
+	",": "0.1667em", ":": "0.2222em", ">": "0.2222em", ";": "0.2778em", " ": "0.25em", "!": "-0.1667em",
+	"quad": "1em", "qquad": "2em",
+}
+
+// fonts maps font commands to mathvariant attribute values.
+var fonts = map[string]string{
+	"mathrm": "normal", "mathit": "italic", "mathbf": "bold", "boldsymbol": "bold-italic",
+	"mathbb": "double-struck", "mathcal": "script", "mathfrak": "fraktur", "mathsf": "sans-serif",
+	"mathtt": "monospace",
+}
+
+// accents maps accent commands to the accent character drawn over, or under for underline, the argument.
+var accents = map[string]string{
+	"hat": "^", "widehat": "^", "bar": "¯", "overline": "¯", "vec": "→", "dot": "˙", "ddot": "¨",
+	"tilde": "~", "widetilde": "~", "underline": "_",
+}
+
+// matrices maps matrix environments to their left and right delimiters.
+var matrices = map[string][2]string{
+	"matrix": {"", ""}, "pmatrix": {"(", ")"}, "bmatrix": {"[", "]"}, "Bmatrix": {"{", "}"},
+	"vmatrix": {"|", "|"}, "Vmatrix": {"‖", "‖"}, "cases": {"{", ""}, "aligned": {"", ""},
+}
+
+// textCommands are commands whose argument is text rather than math.
+var textCommands = map[string]string{"text": "mtext", "mbox": "mtext", "textrm": "mtext", "operatorname": "mi"}
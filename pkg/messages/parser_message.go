@@ -1,5 +1,7 @@
 package messages
 
+import "fmt"
+
 type ParserMessage struct {
 	Type          MessageType
 	StartLine     int    // The line where literal text begins
@@ -7,6 +9,10 @@ type ParserMessage struct {
 	MessageLine   int    // The line in the input that caused the message
 	LiteralText   string // Additional text
 	StartPosition int    // The start position of the problem resulting in a message
+
+	// Args are the values substituted into the message of MessageTypes that have formatted messages, such as the directive
+	// name in DirectiveErrorUnknownDirectiveType.
+	Args []interface{}
 }
 
 // NewParserMessage returns a parser message built from t.
@@ -19,5 +25,11 @@ func NewParserMessage(t MessageType) *ParserMessage {
 // Level returns the MessageType level.
 func (p ParserMessage) Level() string { return p.Type.level() }
 
-// Message returns the message of the MessageType as a string.
-func (p ParserMessage) Message() string { return p.Type.message() }
+// Message returns the message of the MessageType as a string. If the ParserMessage has Args, they are substituted into the
+// message.
+func (p ParserMessage) Message() string {
+	if len(p.Args) > 0 {
+		return fmt.Sprintf(p.Type.message(), p.Args...)
+	}
+	return p.Type.message()
+}
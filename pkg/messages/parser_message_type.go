@@ -20,6 +20,54 @@ const (
 	SectionErrorOverlineUnderlineMismatch
 	SectionErrorTitleLevelInconsistent
 	InlineMarkupWarningExplicitMarkupWithUnIndent
+	DirectiveErrorUnknownDirectiveType
+	DirectiveErrorInvalidDirective
+	DirectiveErrorContentBlockExpected
+	DirectiveErrorEmptyAdmonition
+	DirectiveWarningCodeLanguageUnsupported
+	DirectiveWarningImageURI
+	DirectiveWarningImageSize
+	DirectiveWarningDirectiveDisabled
+	DirectiveSeverePath
+	DirectiveSevereEncoding
+	DirectiveSevereIncludeTextNotFound
+	DirectiveSevereIncludeRecursion
+	DirectiveErrorFileAndContent
+	DirectiveErrorTableContent
+	DirectiveErrorTableWidths
+	DirectiveErrorTableHeaderRows
+	DirectiveErrorTableNoBody
+	DirectiveErrorTableStubColumns
+	DirectiveErrorTableCSV
+	DirectiveWarningTableRaggedRow
+	DirectiveErrorClassNoElement
+	DirectiveErrorMetaInvalid
+	DirectiveErrorMetaNoContent
+	DirectiveErrorMetaAttribute
+	ReferenceInfoDuplicateImplicitTarget
+	ReferenceWarningDuplicateExplicitTarget
+	ReferenceInfoDuplicateExplicitTarget
+	RoleErrorRawDirectUse
+	RoleErrorRawDisabled
+	RoleErrorUnknownRole
+	RoleErrorPEPNumber
+	RoleErrorRFCNumber
+	RoleErrorHandler
+	ListInfoEnumListStartNotOrdinal
+	ListWarningEnumListUnexpectedUnindent
+	ListWarningBulletListUnexpectedUnindent
+	ListWarningDefinitionListUnexpectedUnindent
+	ListInfoDefinitionListMissingLiteralBlankLine
+	BlockQuoteWarningUnexpectedUnindent
+	BlockQuoteErrorUnexpectedIndentation
+	LiteralBlockWarningNoneFound
+	LiteralBlockWarningUnexpectedUnindent
+	LiteralBlockErrorInconsistentQuoting
+	TransitionErrorBegin
+	TransitionErrorAdjacent
+	TransitionErrorEndDocument
+	TransitionSevereUnexpected
+	MathErrorMathML
 )
 
 var messageTypes = [...]string{
@@ -37,6 +85,54 @@ var messageTypes = [...]string{
 	"SectionErrorOverlineUnderlineMismatch",
 	"SectionErrorTitleLevelInconsistent",
 	"InlineMarkupWarningExplicitMarkupWithUnIndent",
+	"DirectiveErrorUnknownDirectiveType",
+	"DirectiveErrorInvalidDirective",
+	"DirectiveErrorContentBlockExpected",
+	"DirectiveErrorEmptyAdmonition",
+	"DirectiveWarningCodeLanguageUnsupported",
+	"DirectiveWarningImageURI",
+	"DirectiveWarningImageSize",
+	"DirectiveWarningDirectiveDisabled",
+	"DirectiveSeverePath",
+	"DirectiveSevereEncoding",
+	"DirectiveSevereIncludeTextNotFound",
+	"DirectiveSevereIncludeRecursion",
+	"DirectiveErrorFileAndContent",
+	"DirectiveErrorTableContent",
+	"DirectiveErrorTableWidths",
+	"DirectiveErrorTableHeaderRows",
+	"DirectiveErrorTableNoBody",
+	"DirectiveErrorTableStubColumns",
+	"DirectiveErrorTableCSV",
+	"DirectiveWarningTableRaggedRow",
+	"DirectiveErrorClassNoElement",
+	"DirectiveErrorMetaInvalid",
+	"DirectiveErrorMetaNoContent",
+	"DirectiveErrorMetaAttribute",
+	"ReferenceInfoDuplicateImplicitTarget",
+	"ReferenceWarningDuplicateExplicitTarget",
+	"ReferenceInfoDuplicateExplicitTarget",
+	"RoleErrorRawDirectUse",
+	"RoleErrorRawDisabled",
+	"RoleErrorUnknownRole",
+	"RoleErrorPEPNumber",
+	"RoleErrorRFCNumber",
+	"RoleErrorHandler",
+	"ListInfoEnumListStartNotOrdinal",
+	"ListWarningEnumListUnexpectedUnindent",
+	"ListWarningBulletListUnexpectedUnindent",
+	"ListWarningDefinitionListUnexpectedUnindent",
+	"ListInfoDefinitionListMissingLiteralBlankLine",
+	"BlockQuoteWarningUnexpectedUnindent",
+	"BlockQuoteErrorUnexpectedIndentation",
+	"LiteralBlockWarningNoneFound",
+	"LiteralBlockWarningUnexpectedUnindent",
+	"LiteralBlockErrorInconsistentQuoting",
+	"TransitionErrorBegin",
+	"TransitionErrorAdjacent",
+	"TransitionErrorEndDocument",
+	"TransitionSevereUnexpected",
+	"MathErrorMathML",
 }
 
 // String implements Stringer and returns the MessageType as a string. The returned string is the MessageType name, not
@@ -72,14 +168,113 @@ func (m MessageType) message() (s string) {
 		s = "Title level inconsistent."
 	case InlineMarkupWarningExplicitMarkupWithUnIndent:
 		s = "Explicit markup ends without a blank line; unexpected unindent."
+	case DirectiveErrorUnknownDirectiveType:
+		s = "Unknown directive type \"%s\"."
+	case DirectiveErrorInvalidDirective:
+		s = "Error in \"%s\" directive:\n%s."
+	case DirectiveErrorContentBlockExpected:
+		s = "Content block expected for the \"%s\" directive; none found."
+	case DirectiveErrorEmptyAdmonition:
+		s = "The \"%s\" admonition is empty; content required."
+	case DirectiveWarningCodeLanguageUnsupported:
+		s = "Cannot analyze code. No lexer found for \"%s\"."
+	case DirectiveWarningImageURI:
+		s = "Cannot resolve image URI \"%s\": %s."
+	case DirectiveWarningImageSize:
+		s = "Cannot scale image!\n  Could not get size from \"%s\":\n  %s"
+	case DirectiveWarningDirectiveDisabled:
+		s = "\"%s\" directive disabled."
+	case DirectiveSeverePath:
+		s = "Problems with \"%s\" directive path:\n%s."
+	case DirectiveSevereEncoding:
+		s = "Problem with \"%s\" directive:\n%s."
+	case DirectiveSevereIncludeTextNotFound:
+		s = "Problem with \"%s\" option of \"include\" directive:\nText not found."
+	case DirectiveSevereIncludeRecursion:
+		s = "Circular inclusion in \"include\" directive:\n%s"
+	case DirectiveErrorFileAndContent:
+		s = "\"%s\" directive may not both specify an external file and have content."
+	case DirectiveErrorTableContent:
+		s = "Error parsing content block for the \"%s\" directive: %s."
+	case DirectiveErrorTableWidths:
+		s = "\"%s\" widths do not match the number of columns in table (%d)."
+	case DirectiveErrorTableHeaderRows:
+		s = "%d header row(s) specified but only %d row(s) of data supplied (\"%s\" directive)."
+	case DirectiveErrorTableNoBody:
+		s = "Insufficient data supplied (%d row(s)); no data remaining for table body, required by \"%s\" directive."
+	case DirectiveErrorTableStubColumns:
+		s = "%d stub column(s) specified but only %d columns(s) of data supplied (\"%s\" directive)."
+	case DirectiveErrorTableCSV:
+		s = "Error with CSV data in \"%s\" directive:\n%s"
+	case DirectiveWarningTableRaggedRow:
+		s = "Row %d of the \"%s\" directive has %d column(s) instead of %d; empty cells added."
+	case DirectiveErrorClassNoElement:
+		s = "No suitable element following \"%s\" directive"
+	case DirectiveErrorMetaInvalid:
+		s = "Invalid meta directive."
+	case DirectiveErrorMetaNoContent:
+		s = "No content for meta tag \"%s\"."
+	case DirectiveErrorMetaAttribute:
+		s = "Error parsing meta tag attribute \"%s\": %s."
+	case ReferenceInfoDuplicateImplicitTarget:
+		s = "Duplicate implicit target name: \"%s\"."
+	case ReferenceWarningDuplicateExplicitTarget, ReferenceInfoDuplicateExplicitTarget:
+		s = "Duplicate explicit target name: \"%s\"."
+	case RoleErrorRawDirectUse:
+		s = "The \"raw\" role may not be used directly.\n" +
+			"Instead, use the \"role\" directive to create a new role with an associated format."
+	case RoleErrorRawDisabled:
+		s = "raw (and derived) roles disabled"
+	case RoleErrorUnknownRole:
+		s = "Unknown interpreted text role \"%s\"."
+	case RoleErrorPEPNumber:
+		s = "PEP number must be a number from 0 to 9999; \"%s\" is invalid."
+	case RoleErrorRFCNumber:
+		s = "RFC number must be a number greater than or equal to 1; \"%s\" is invalid."
+	case RoleErrorHandler:
+		s = "Error in \"%s\" role:\n%s."
+	case ListInfoEnumListStartNotOrdinal:
+		s = "Enumerated list start value not ordinal-1: \"%s\" (ordinal %d)"
+	case ListWarningEnumListUnexpectedUnindent:
+		s = "Enumerated list ends without a blank line; unexpected unindent."
+	case ListWarningBulletListUnexpectedUnindent:
+		s = "Bullet list ends without a blank line; unexpected unindent."
+	case ListWarningDefinitionListUnexpectedUnindent:
+		s = "Definition list ends without a blank line; unexpected unindent."
+	case ListInfoDefinitionListMissingLiteralBlankLine:
+		s = "Blank line missing before literal block (after the \"::\")? Interpreted as a definition list item."
+	case BlockQuoteWarningUnexpectedUnindent:
+		s = "Block quote ends without a blank line; unexpected unindent."
+	case BlockQuoteErrorUnexpectedIndentation:
+		s = "Unexpected indentation."
+	case LiteralBlockWarningNoneFound:
+		s = "Literal block expected; none found."
+	case LiteralBlockWarningUnexpectedUnindent:
+		s = "Literal block ends without a blank line; unexpected unindent."
+	case LiteralBlockErrorInconsistentQuoting:
+		s = "Inconsistent literal block quoting."
+	case TransitionErrorBegin:
+		s = "Document or section may not begin with a transition."
+	case TransitionErrorAdjacent:
+		s = "At least one body element must separate transitions; adjacent transitions are not allowed."
+	case TransitionErrorEndDocument:
+		s = "Document may not end with a transition."
+	case TransitionSevereUnexpected:
+		s = "Unexpected section title or transition."
+	case MathErrorMathML:
+		s = "Cannot convert math to MathML: %s."
 	}
 	return
 }
 
 // level returns the MessageType level.
 func (m MessageType) level() (s string) {
-	if strings.Contains(m.String(), "Warning") {
+	if strings.Contains(m.String(), "Info") {
+		s = "INFO"
+	} else if strings.Contains(m.String(), "Warning") {
 		s = "WARNING"
+	} else if strings.Contains(m.String(), "Severe") {
+		s = "SEVERE"
 	} else {
 		s = "ERROR"
 	}
@@ -91,3 +286,9 @@ func IsSectionMessage(m MessageType) bool { return strings.Contains(m.String(),
 
 // IsInlineMarkupMessage returns true if the MessageType m is a inline markup message type.
 func IsInlineMarkupMessage(m MessageType) bool { return strings.Contains(m.String(), "InlineMarkup") }
+
+// IsRoleMessage returns true if the MessageType m is an interpreted text role message type.
+func IsRoleMessage(m MessageType) bool { return strings.HasPrefix(m.String(), "Role") }
+
+// IsDirectiveMessage returns true if the MessageType m is a directive message type.
+func IsDirectiveMessage(m MessageType) bool { return strings.Contains(m.String(), "Directive") }
@@ -0,0 +1,61 @@
+package parser
+
+import (
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+)
+
+// admonitionKinds are the specific admonitions. Each has a fixed title supplied by the writer.
+var admonitionKinds = []string{"attention", "caution", "danger", "error", "hint", "important", "note", "tip", "warning"}
+
+var admonitionOptions = map[string]optionFunc{
+	"class": classOption,
+	"name":  unchangedOption,
+}
+
+func init() {
+	for _, kind := range admonitionKinds {
+		registerDirective(kind, &directive{
+			optionSpec: admonitionOptions,
+			hasContent: true,
+			run:        admonition,
+		})
+	}
+	registerDirective("admonition", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec:              admonitionOptions,
+		hasContent:              true,
+		run:                     admonition,
+	})
+}
+
+// admonition creates an AdmonitionNode from the directive block. The content of the directive is parsed as body elements.
+// Generic admonitions use the directive argument as the title and receive an "admonition-<title>" class if the class
+// option is not given.
+func admonition(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorEmptyAdmonition, d.name)
+	}
+
+	a := doc.NewAdmonition(d.name, p.directiveItem(d))
+	a.Classes = d.classes()
+	a.Names = d.names()
+
+	if d.name == "admonition" {
+		title := d.arguments[0]
+		a.Title = &doc.TitleNode{
+			Type:          doc.NodeTitle,
+			Length:        len([]rune(title)),
+			Line:          d.line,
+			StartPosition: d.argPosition,
+		}
+		a.Title.NodeList = p.parseInline(title, d.line, d.argPosition)
+		if !d.hasOption("class") {
+			a.Classes = []string{"admonition-" + doc.MakeID(title)}
+		}
+	}
+
+	a.NodeList = p.subParse(d.content, d.contentLine, d.startPosition-1+d.indent)
+	return doc.NodeList{a}, nil
+}
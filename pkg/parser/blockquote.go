@@ -1,33 +1,160 @@
 package parser
 
 import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
 	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
 	tok "github.com/demizer/go-rst/pkg/token"
 )
 
-func (p *Parser) emptyblockquote(i *tok.Item) {
-	//
-	//  FIXME: Blockquote parsing is NOT fully implemented.
-	//
-	sec := doc.NewEmptyBlockQuote(i)
-	p.nodeTarget.Append(sec)
-	p.nodeTarget.SetParent(sec)
-	p.bqLevel = sec
-}
-
-func (p *Parser) blockquote(i *tok.Item) {
-	//
-	//  FIXME: Blockquote parsing is NOT fully implemented.
-	//
-	if p.bqLevel != nil {
-		// Parser Test 03.02.07.00
-		p.Msg("Adding blockquote text as NodeText to existing blockquote")
-		p.bqLevel.NodeList.Append(doc.NewParagraphWithNodeText(i))
-		return
-	}
-	p.Msg("Creating blockquote")
-	sec := doc.NewBlockQuote(i)
-	p.nodeTarget.Append(sec)
-	p.nodeTarget.SetParent(sec)
-	p.bqLevel = sec
+// attributionMarker matches the start of a block quote attribution, two or three hyphens or an em dash followed by text.
+var attributionMarker = regexp.MustCompile(`^(---?|—)( +[^ ]|[^ -])`)
+
+// blockQuoteLevel is a block quote that is being parsed. indent is the absolute indentation of the contents of the block
+// quote.
+type blockQuoteLevel struct {
+	node   *doc.BlockQuoteNode
+	indent int
+}
+
+// blockQuoteLevels is the stack of block quotes being parsed. The stack is shared with the parsers of the block quote
+// contents, levels[0] is the outermost block quote.
+type blockQuoteLevels struct {
+	levels []*blockQuoteLevel
+}
+
+// push adds a block quote level for the block quote n with contents at indent.
+func (b *blockQuoteLevels) push(n *doc.BlockQuoteNode, indent int) {
+	b.levels = append(b.levels, &blockQuoteLevel{node: n, indent: indent})
+}
+
+// pop removes the innermost block quote level.
+func (b *blockQuoteLevels) pop() {
+	b.levels = b.levels[:len(b.levels)-1]
+}
+
+// last returns the innermost block quote level, or nil if no block quote is being parsed.
+func (b *blockQuoteLevels) last() *blockQuoteLevel {
+	if len(b.levels) == 0 {
+		return nil
+	}
+	return b.levels[len(b.levels)-1]
+}
+
+// isIndented returns true if i is white space at the start of a line. Only spaces count as indentation, a line beginning
+// with other Unicode white space is not indented.
+func (p *Parser) isIndented(i *tok.Item) bool {
+	if i == nil || i.Type != tok.Space || !strings.HasPrefix(i.Text, " ") {
+		return false
+	}
+	return i.StartPosition-1-p.lex.PositionOffset == 0
+}
+
+// blockQuote parses the indented lines beginning at the line of token i as block quotes. The indented block ends at the first
+// line that is not indented. The block is split into several block quotes at attributions, and at the blank lines following
+// them. A block quote ending without a blank line produces a warning.
+func (p *Parser) blockQuote(i *tok.Item) {
+	lines := strings.Split(p.text, "\n")
+	line := i.Line - p.lex.LineOffset
+
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+
+	block, indent, end := indentedBlock(lines, line-1, 0)
+	p.skipLines(end)
+	start := line
+	for len(block) > 0 {
+		quote, attribution, rest := splitAttribution(block)
+		bq := doc.NewBlockQuote(p.lex.Locate(&tok.Item{Line: start + p.lex.LineOffset,
+			StartPosition: indent + 1 + p.lex.PositionOffset}))
+		p.blockQuotes.push(bq, indent+p.lex.PositionOffset)
+		bq.NodeList = p.subParse(quote, start+p.lex.LineOffset, indent+p.lex.PositionOffset)
+		if attribution != nil {
+			p.attribution(attribution, start+len(quote))
+		}
+		p.blockQuotes.pop()
+		p.nodeTarget.Append(bq)
+
+		start += len(block) - len(rest)
+		block = rest
+		for len(block) > 0 && strings.TrimSpace(block[0]) == "" {
+			block = block[1:]
+			start++
+		}
+	}
+	if end < len(lines) && strings.TrimSpace(lines[end]) != "" {
+		p.blockQuoteMessage(mes.BlockQuoteWarningUnexpectedUnindent, end+1+p.lex.LineOffset, 1+p.lex.PositionOffset)
+	}
+}
+
+// splitAttribution splits the dedented lines of a block quote at the first attribution. An attribution is a line beginning
+// with an attribution marker that follows a blank line and at least one line of text. The lines following the first line
+// of the attribution up to the next blank line must have the same indentation, otherwise the line is not an attribution.
+// The lines of the block quote before the attribution, the lines of the attribution and the remaining lines are returned.
+func splitAttribution(block []string) (quote, attribution, rest []string) {
+	nonBlankSeen := false
+	blank := -1
+	for x, l := range block {
+		if strings.TrimSpace(l) == "" {
+			blank = x
+			continue
+		}
+		if nonBlankSeen && blank == x-1 && attributionMarker.MatchString(l) {
+			if end, ok := attributionEnd(block, x); ok {
+				return block[:x], block[x:end], block[end:]
+			}
+		}
+		nonBlankSeen = true
+	}
+	return block, nil, nil
+}
+
+// attributionEnd returns the index of the line following the attribution beginning at line start of block. False is
+// returned if the lines of the attribution after the first line do not have the same indentation.
+func attributionEnd(block []string, start int) (int, bool) {
+	indent := -1
+	x := start + 1
+	for ; x < len(block); x++ {
+		if strings.TrimSpace(block[x]) == "" {
+			break
+		}
+		if indent < 0 {
+			indent = indentation(block[x])
+		} else if indentation(block[x]) != indent {
+			return 0, false
+		}
+	}
+	return x, true
+}
+
+// attribution adds an AttributionNode to the innermost block quote for the attribution lines beginning at line. The
+// attribution marker is removed from the text of the attribution.
+func (p *Parser) attribution(lines []string, line int) {
+	bq := p.blockQuotes.last()
+	first := lines[0]
+	marker := 0
+	for _, m := range []string{"---", "--", "—"} {
+		if strings.HasPrefix(first, m) {
+			marker = len(m)
+			break
+		}
+	}
+	marker += indentation(first[marker:])
+	text := first[marker:]
+	for _, l := range lines[1:] {
+		text += "\n" + strings.TrimLeft(l, " ")
+	}
+	text = strings.TrimRight(text, " \n")
+	a := doc.NewAttribution(p.lex.Locate(&tok.Item{Text: text, Length: utf8.RuneCountInString(text),
+		Line: line + p.lex.LineOffset, StartPosition: bq.indent + marker + 1}))
+	a.NodeList = p.parseInline(text, a.Line, a.StartPosition)
+	bq.node.NodeList.Append(a)
 }
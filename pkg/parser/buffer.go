@@ -37,7 +37,7 @@ func (t *tokenBuffer) append(item *tok.Item) int {
 	if item == nil {
 		return -1
 	}
-	for i := 0; i < len(t.buf)-1; i++ {
+	for i := 0; i < len(t.buf); i++ {
 		if t.buf[i] == nil {
 			t.buf[i] = item
 			return i
@@ -129,9 +129,6 @@ func (t *tokenBuffer) next(pos int) *tok.Item {
 		t.index++
 		t.token = t.buf[t.index]
 	} else {
-		if t.index > 98 { //&& t.buf[t.index+1] == nil {
-			t.dumpBufferFullExit()
-		}
 		if ind := t.append(t.lex.NextItem()); ind != -1 {
 			t.printToken("got token from lexer", t.buf[ind])
 			t.index = ind
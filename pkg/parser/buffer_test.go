@@ -51,16 +51,16 @@ var parserBackupTests = [...]parserBackupTest{
 		nextNum: 2, backupNum: 1,
 		expectIndex: 0,
 		// backToken should be nil
-		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7},
-		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7},
+		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7, Offset: 0, EndOffset: 7, Column: 1, EndLine: 1, EndColumn: 8},
+		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7, Offset: 8, EndOffset: 15, Column: 1, EndLine: 2, EndColumn: 8},
 	},
 	{
 		name:    "Double backup",
 		input:   "Title 1\n=======\n\nParagraph 1.\n\nParagraph 2.",
 		nextNum: 2, backupNum: 2,
 		// backToken is nil
-		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7},
-		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7},
+		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7, Offset: 0, EndOffset: 7, Column: 1, EndLine: 1, EndColumn: 8},
+		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7, Offset: 8, EndOffset: 15, Column: 1, EndLine: 2, EndColumn: 8},
 	},
 	{
 		name:  "Triple backup",
@@ -68,8 +68,8 @@ var parserBackupTests = [...]parserBackupTest{
 		// With backupNum = 3, we try to backup past the beginning of the slice
 		nextNum: 2, backupNum: 3,
 		// BackToken is nil
-		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7},
-		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7},
+		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7, Offset: 0, EndOffset: 7, Column: 1, EndLine: 1, EndColumn: 8},
+		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7, Offset: 8, EndOffset: 15, Column: 1, EndLine: 2, EndColumn: 8},
 	},
 	{
 		name:  "Quadruple backup",
@@ -77,9 +77,9 @@ var parserBackupTests = [...]parserBackupTest{
 		// cycle next() until the end of the lexing (EOF)
 		nextNum: 13, backupNum: 4,
 		expectIndex: 8,
-		backToken:   &tok.Item{ID: 8, Type: tok.Text, Text: "Three", Line: 8, StartPosition: 1, Length: 5},
-		indexToken:  &tok.Item{ID: 9, Type: tok.BlankLine, Text: "\n", Line: 9, StartPosition: 1, Length: 1},
-		peekToken:   &tok.Item{ID: 10, Type: tok.Text, Text: "Four", Line: 10, StartPosition: 1, Length: 4},
+		backToken:   &tok.Item{ID: 8, Type: tok.Text, Text: "Three", Line: 8, StartPosition: 1, Length: 5, Offset: 23, EndOffset: 28, Column: 1, EndLine: 8, EndColumn: 6},
+		indexToken:  &tok.Item{ID: 9, Type: tok.BlankLine, Text: "\n", Line: 9, StartPosition: 1, Length: 1, Offset: 29, EndOffset: 29, Column: 1, EndLine: 9, EndColumn: 1},
+		peekToken:   &tok.Item{ID: 10, Type: tok.Text, Text: "Four", Line: 10, StartPosition: 1, Length: 4, Offset: 30, EndOffset: 34, Column: 1, EndLine: 10, EndColumn: 5},
 	},
 }
 
@@ -138,70 +138,70 @@ var parserNextTests = [...]parserNextTest{
 		name:       "Single next from start",
 		input:      "Test\n=====\n\nParagraph.",
 		nextNum:    1,
-		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
+		indexToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		peekToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
 	},
 	{
 		name:    "Double next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 2, expectIndex: 1,
-		backToken:  &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
-		peekToken:  &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1},
+		backToken:  &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
+		peekToken:  &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1},
 	},
 	{
 		name:    "Triple next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 3, expectIndex: 2,
-		backToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
-		indexToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1},
-		peekToken:  &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10},
+		backToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
+		indexToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1},
+		peekToken:  &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10, Offset: 12, EndOffset: 22, Column: 1, EndLine: 4, EndColumn: 11},
 	},
 	{
 		name:    "Quadruple next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 4, expectIndex: 3,
-		backToken:  &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1},
-		indexToken: &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10},
-		peekToken:  &tok.Item{ID: 5, Type: tok.EOF, Line: 4, StartPosition: 11, Length: 0},
+		backToken:  &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1},
+		indexToken: &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10, Offset: 12, EndOffset: 22, Column: 1, EndLine: 4, EndColumn: 11},
+		peekToken:  &tok.Item{ID: 5, Type: tok.EOF, Line: 4, StartPosition: 11, Offset: 22, EndOffset: 22, Column: 11, EndLine: 4, EndColumn: 11},
 	},
 	{
 		name:    "Quintuple next",
 		input:   "Test\n=====\n\nParagraph.\n\n",
 		nextNum: 5, expectIndex: 4,
-		backToken:  &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10},
-		indexToken: &tok.Item{ID: 5, Type: tok.BlankLine, Text: "\n", Line: 5, StartPosition: 1, Length: 1},
-		peekToken:  &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1},
+		backToken:  &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10, Offset: 12, EndOffset: 22, Column: 1, EndLine: 4, EndColumn: 11},
+		indexToken: &tok.Item{ID: 5, Type: tok.BlankLine, Text: "\n", Line: 5, StartPosition: 1, Length: 1, Offset: 23, EndOffset: 23, Column: 1, EndLine: 5, EndColumn: 1},
+		peekToken:  &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1, Offset: 24, EndOffset: 24, Column: 1, EndLine: 6, EndColumn: 1},
 	},
 	{
 		name:    "Sextuple next",
 		input:   "Test\n=====\n\nParagraph.\n\n",
 		nextNum: 6, expectIndex: 5,
-		backToken:  &tok.Item{ID: 5, Type: tok.BlankLine, Text: "\n", Line: 5, StartPosition: 1, Length: 1},
-		indexToken: &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1},
-		peekToken:  &tok.Item{ID: 7, Type: tok.EOF, Line: 6, StartPosition: 1, Length: 0},
+		backToken:  &tok.Item{ID: 5, Type: tok.BlankLine, Text: "\n", Line: 5, StartPosition: 1, Length: 1, Offset: 23, EndOffset: 23, Column: 1, EndLine: 5, EndColumn: 1},
+		indexToken: &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1, Offset: 24, EndOffset: 24, Column: 1, EndLine: 6, EndColumn: 1},
+		peekToken:  &tok.Item{ID: 7, Type: tok.EOF, Line: 6, StartPosition: 1, Offset: 24, EndOffset: 24, Column: 1, EndLine: 6, EndColumn: 1},
 	},
 	{
 		name:    "Septuple next",
 		input:   "Test\n=====\n\nParagraph.\n\n",
 		nextNum: 7, expectIndex: 6,
-		backToken:  &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1},
-		indexToken: &tok.Item{ID: 7, Type: tok.EOF, Line: 6, StartPosition: 1, Length: 0},
+		backToken:  &tok.Item{ID: 6, Type: tok.BlankLine, Text: "\n", Line: 6, StartPosition: 1, Length: 1, Offset: 24, EndOffset: 24, Column: 1, EndLine: 6, EndColumn: 1},
+		indexToken: &tok.Item{ID: 7, Type: tok.EOF, Line: 6, StartPosition: 1, Offset: 24, EndOffset: 24, Column: 1, EndLine: 6, EndColumn: 1},
 	},
 	{
 		name:    "Two next() on one line of input",
 		input:   "Test",
 		nextNum: 2, expectIndex: 1,
-		backToken:  &tok.Item{ID: 1, Type: tok.Text, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken: &tok.Item{ID: 2, Type: tok.EOF, Line: 1, StartPosition: 5, Length: 0},
+		backToken:  &tok.Item{ID: 1, Type: tok.Text, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken: &tok.Item{ID: 2, Type: tok.EOF, Line: 1, StartPosition: 5, Offset: 4, EndOffset: 4, Column: 5, EndLine: 1, EndColumn: 5},
 	},
 	{
 		name:    "Three next() on one line of input; Test channel close.",
 		input:   "Test",
 		nextNum: 4, expectIndex: 1, // Index is at EOF
 		// The channel should be closed on the second next(), otherwise a deadlock would occur.
-		backToken:  &tok.Item{ID: 1, Type: tok.Text, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken: &tok.Item{ID: 2, Type: tok.EOF, Line: 1, StartPosition: 5, Length: 0},
+		backToken:  &tok.Item{ID: 1, Type: tok.Text, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken: &tok.Item{ID: 2, Type: tok.EOF, Line: 1, StartPosition: 5, Offset: 4, EndOffset: 4, Column: 5, EndLine: 1, EndColumn: 5},
 	},
 }
 
@@ -257,35 +257,35 @@ var parserPeekTests = [...]parserPeekTest{
 		name:    "Single peek no next",
 		input:   "Test\n=====\n\nParagraph.",
 		peekNum: 1, expectIndex: -1,
-		peekToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
+		peekToken: &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
 	},
 	{
 		name:    "Double peek no next",
 		input:   "Test\n=====\n\nParagraph.",
 		peekNum: 2, expectIndex: -1,
-		peekToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
+		peekToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
 	},
 	{
 		name:    "Triple peek no next",
 		input:   "Test\n=====\n\nParagraph.",
 		peekNum: 3, expectIndex: -1,
-		peekToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1},
+		peekToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1},
 	},
 	{
 		name:    "Triple peek and double next",
 		input:   "Test\n=====\n\nOne\nTest 2\n=====\n\nTwo",
 		nextNum: 2, peekNum: 3, expectIndex: 1,
-		backToken:  &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
-		peekToken:  &tok.Item{ID: 5, Type: tok.Title, Text: "Test 2", Line: 5, StartPosition: 1, Length: 6},
+		backToken:  &tok.Item{ID: 1, Type: tok.Title, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken: &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
+		peekToken:  &tok.Item{ID: 5, Type: tok.Title, Text: "Test 2", Line: 5, StartPosition: 1, Length: 6, Offset: 16, EndOffset: 22, Column: 1, EndLine: 5, EndColumn: 7},
 	},
 	{
 		name:    "Quadruple peek and triple next",
 		input:   "Test\n=====\n\nOne\nTest 2\n=====\n\nTwo",
 		nextNum: 3, peekNum: 4, expectIndex: 2,
-		backToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
-		indexToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1},
-		peekToken:  &tok.Item{ID: 7, Type: tok.BlankLine, Text: "\n", Line: 7, StartPosition: 1, Length: 1},
+		backToken:  &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
+		indexToken: &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1},
+		peekToken:  &tok.Item{ID: 7, Type: tok.BlankLine, Text: "\n", Line: 7, StartPosition: 1, Length: 1, Offset: 29, EndOffset: 29, Column: 1, EndLine: 7, EndColumn: 1},
 	},
 	{
 		name:        "Peek on no input",
@@ -350,23 +350,23 @@ var parserPeekBackTests = [...]parserPeekBackTest{
 		name:    "Single peekBack with one next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 1, peekBackNum: 1,
-		indexToken: &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
+		indexToken: &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
 	},
 	{
 		name:    "Single peekBack with two next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 2, peekBackNum: 1,
 		expectIndex: 1,
-		backToken:   &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken:  &tok.Item{ID: 2, Type: 3, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
+		backToken:   &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken:  &tok.Item{ID: 2, Type: 3, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
 	},
 	{
 		name:    "Single peekBack with double next",
 		input:   "Test\n=====\n\nParagraph.",
 		nextNum: 2, peekBackNum: 1,
 		expectIndex: 1,
-		backToken:   &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4},
-		indexToken:  &tok.Item{ID: 2, Type: 3, Text: "=====", Line: 2, StartPosition: 1, Length: 5},
+		backToken:   &tok.Item{ID: 1, Type: 2, Text: "Test", Line: 1, StartPosition: 1, Length: 4, Offset: 0, EndOffset: 4, Column: 1, EndLine: 1, EndColumn: 5},
+		indexToken:  &tok.Item{ID: 2, Type: 3, Text: "=====", Line: 2, StartPosition: 1, Length: 5, Offset: 5, EndOffset: 10, Column: 1, EndLine: 2, EndColumn: 6},
 	},
 }
 
@@ -404,13 +404,13 @@ func TestParserNextAfterPeekAtEOF(t *testing.T) {
 	}
 
 	n := tr.next(3)
-	assert.Equal(t, &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1}, n, "expect token from next(3)")
+	assert.Equal(t, &tok.Item{ID: 3, Type: tok.BlankLine, Text: "\n", Line: 3, StartPosition: 1, Length: 1, Offset: 11, EndOffset: 11, Column: 1, EndLine: 3, EndColumn: 1}, n, "expect token from next(3)")
 
 	pk := tr.peek(2)
-	assert.Equal(t, &tok.Item{ID: 5, Type: tok.EOF, Line: 4, StartPosition: 11}, pk, "expect token from peek(2)")
+	assert.Equal(t, &tok.Item{ID: 5, Type: tok.EOF, Line: 4, StartPosition: 11, Offset: 22, EndOffset: 22, Column: 11, EndLine: 4, EndColumn: 11}, pk, "expect token from peek(2)")
 
 	nt := tr.next(1)
-	assert.Equal(t, &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10}, nt, "expect token from next() after peek()")
+	assert.Equal(t, &tok.Item{ID: 4, Type: tok.Text, Text: "Paragraph.", Line: 4, StartPosition: 1, Length: 10, Offset: 12, EndOffset: 22, Column: 1, EndLine: 4, EndColumn: 11}, nt, "expect token from next() after peek()")
 }
 
 func TestParserNextPeekNextInComment(t *testing.T) {
@@ -425,16 +425,16 @@ func TestParserNextPeekNextInComment(t *testing.T) {
 	assert.Equal(t, -1, tr.index, "expect index to equal -1")
 
 	n := tr.next(1)
-	assert.Equal(t, &tok.Item{ID: 1, Type: tok.CommentMark, Text: "..", Line: 1, StartPosition: 1, Length: 2}, n, "expect token from next(1)")
+	assert.Equal(t, &tok.Item{ID: 1, Type: tok.CommentMark, Text: "..", Line: 1, StartPosition: 1, Length: 2, Offset: 0, EndOffset: 2, Column: 1, EndLine: 1, EndColumn: 3}, n, "expect token from next(1)")
 
 	assert.Equal(t, 0, tr.index, "expect index to equal 0")
 
 	pk := tr.peek(2)
-	assert.Equal(t, &tok.Item{ID: 3, Type: tok.Text, Text: "A comment.", Line: 1, StartPosition: 4, Length: 10}, pk, "expect token from peek(2)")
+	assert.Equal(t, &tok.Item{ID: 3, Type: tok.Text, Text: "A comment.", Line: 1, StartPosition: 4, Length: 10, Offset: 3, EndOffset: 13, Column: 4, EndLine: 1, EndColumn: 14}, pk, "expect token from peek(2)")
 	assert.Equal(t, 0, tr.index, "expect index to equal 0")
 
 	nt := tr.next(2)
-	assert.Equal(t, &tok.Item{ID: 3, Type: tok.Text, Text: "A comment.", Line: 1, StartPosition: 4, Length: 10}, nt, "expect token from next(2) after peek(2)")
+	assert.Equal(t, &tok.Item{ID: 3, Type: tok.Text, Text: "A comment.", Line: 1, StartPosition: 4, Length: 10, Offset: 3, EndOffset: 13, Column: 4, EndLine: 1, EndColumn: 14}, nt, "expect token from next(2) after peek(2)")
 	assert.Equal(t, 2, tr.index, "expect index to equal 2")
 
 }
@@ -451,7 +451,7 @@ func TestParserAppend(t *testing.T) {
 	}
 	tr.next(203)
 	assert.Equal(t, 201, tr.index, "expect index to equal 201")
-	assert.Equal(t, &tok.Item{ID: 202, Type: tok.EOF, Line: 201, StartPosition: 1, Length: 0}, tr.token, "expect index token")
+	assert.Equal(t, &tok.Item{ID: 202, Type: tok.EOF, Line: 201, StartPosition: 1, Offset: 800, EndOffset: 800, Column: 1, EndLine: 201, EndColumn: 1}, tr.token, "expect index token")
 }
 
 func TestParserNextSequential(t *testing.T) {
@@ -499,7 +499,7 @@ func TestParserPeekAtEndOfBuffer(t *testing.T) {
 	tr.peek(1)
 	tr.dumpBufferFull()
 	assert.Equal(t, 201, tr.index, "expect index to equal 201")
-	assert.Equal(t, &tok.Item{ID: 202, Type: tok.EOF, Line: 201, StartPosition: 1, Length: 0}, tr.token, "expect index token")
+	assert.Equal(t, &tok.Item{ID: 202, Type: tok.EOF, Line: 201, StartPosition: 1, Offset: 2400, EndOffset: 2400, Column: 1, EndLine: 201, EndColumn: 1}, tr.token, "expect index token")
 }
 
 func TestParserPeekSkip(t *testing.T) {
@@ -511,7 +511,7 @@ func TestParserPeekSkip(t *testing.T) {
 	}
 	ps := tr.peekSkip(tok.Title)
 	assert.Equal(t, -1, tr.index, "expect index to equal -1")
-	assert.Equal(t, &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7}, ps, "expect peek skip token")
+	assert.Equal(t, &tok.Item{ID: 2, Type: tok.SectionAdornment, Text: "=======", Line: 2, StartPosition: 1, Length: 7, Offset: 8, EndOffset: 15, Column: 1, EndLine: 2, EndColumn: 8}, ps, "expect peek skip token")
 }
 
 func TestParserPeekBackTo(t *testing.T) {
@@ -524,15 +524,15 @@ func TestParserPeekBackTo(t *testing.T) {
 	tr.next(4)
 	pb := tr.peekBackTo(tok.Title)
 	assert.Equal(t, 3, tr.index, "expect index to equal 3")
-	assert.Equal(t, &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7}, pb, "expect peek back token")
+	assert.Equal(t, &tok.Item{ID: 1, Type: tok.Title, Text: "Title 1", Line: 1, StartPosition: 1, Length: 7, Offset: 0, EndOffset: 7, Column: 1, EndLine: 1, EndColumn: 8}, pb, "expect peek back token")
 }
 
 func TestParserPeekLine(t *testing.T) {
 	expect := [4]*tok.Item{
-		&tok.Item{ID: 1, Type: tok.Text, Text: "Title containing ", Line: 1, StartPosition: 1, Length: 17},
-		&tok.Item{ID: 2, Type: tok.InlineEmphasisOpen, Text: "*", Line: 1, StartPosition: 18, Length: 1},
-		&tok.Item{ID: 3, Type: tok.InlineEmphasis, Text: "inline", Line: 1, StartPosition: 19, Length: 6},
-		&tok.Item{ID: 4, Type: tok.InlineEmphasisClose, Text: "*", Line: 1, StartPosition: 25, Length: 1},
+		&tok.Item{ID: 1, Type: tok.Text, Text: "Title containing ", Line: 1, StartPosition: 1, Length: 17, Offset: 0, EndOffset: 17, Column: 1, EndLine: 1, EndColumn: 18},
+		&tok.Item{ID: 2, Type: tok.InlineEmphasisOpen, Text: "*", Line: 1, StartPosition: 18, Length: 1, Offset: 17, EndOffset: 18, Column: 18, EndLine: 1, EndColumn: 19},
+		&tok.Item{ID: 3, Type: tok.InlineEmphasis, Text: "inline", Line: 1, StartPosition: 19, Length: 6, Offset: 18, EndOffset: 24, Column: 19, EndLine: 1, EndColumn: 25},
+		&tok.Item{ID: 4, Type: tok.InlineEmphasisClose, Text: "*", Line: 1, StartPosition: 25, Length: 1, Offset: 24, EndOffset: 25, Column: 25, EndLine: 1, EndColumn: 26},
 	}
 
 	input := "Title containing *inline*\nParagraph."
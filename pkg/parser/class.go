@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"strings"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+)
+
+func init() {
+	registerDirective("class", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		hasContent:              true,
+		run:                     classDirective,
+	})
+}
+
+// classDirective adds the class names given as the argument to the elements of the content. Without content, a
+// PendingNode is returned and the classes are added to the first element following the directive when parsing is complete.
+func classDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	value, err := classOption(d.arguments[0])
+	if err != nil {
+		return nil, err
+	}
+	classes := strings.Fields(value)
+
+	if len(d.content) > 0 {
+		nodes := p.subParse(d.content, d.contentLine, d.startPosition-1+d.indent)
+		for _, n := range nodes {
+			if c := doc.Classes(n); c != nil {
+				*c = append(*c, classes...)
+			}
+		}
+		return nodes, nil
+	}
+
+	pending := doc.NewPending(d.name, p.directiveItem(d))
+	p.addTransform(transformPriorityClass, func(p *Parser) {
+		n, _ := nextElement(*p.Nodes, pending)
+		p.Nodes.Remove(pending)
+		if c := doc.Classes(n); c != nil {
+			*c = append(*c, classes...)
+			return
+		}
+		p.directiveMessage(mes.DirectiveErrorClassNoElement, d, d.name)
+	})
+	return doc.NodeList{pending}, nil
+}
+
+// nextElement returns the first body element following n in the document tree l. If n is the last element of its parent,
+// the element following the parent is returned. Comments, system messages, and pending nodes are skipped. found is false
+// if n is not in l.
+func nextElement(l doc.NodeList, n doc.Node) (next doc.Node, found bool) {
+	first := func(nl doc.NodeList) doc.Node {
+		for _, x := range nl {
+			switch x.(type) {
+			case *doc.CommentNode, *doc.SystemMessageNode, *doc.PendingNode:
+				continue
+			}
+			return x
+		}
+		return nil
+	}
+	for i, x := range l {
+		if x == n {
+			return first(l[i+1:]), true
+		}
+		if c := doc.Children(x); c != nil {
+			if next, found := nextElement(*c, n); found {
+				if next == nil {
+					next = first(l[i+1:])
+				}
+				return next, true
+			}
+		}
+	}
+	return nil, false
+}
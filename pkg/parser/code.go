@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/highlight"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	code := &directive{
+		optionalArguments: 1,
+		optionSpec: map[string]optionFunc{
+			"class":        classOption,
+			"name":         unchangedOption,
+			"number-lines": numberLinesOption,
+			"tab-width":    intOption,
+		},
+		hasContent: true,
+		run:        codeBlock,
+	}
+	registerDirective("code", code)
+	registerDirective("code-block", code)
+	registerDirective("sourcecode", code)
+
+	registerRole("code", codeRole(""))
+}
+
+// numberLinesOption validates the optional start line number of the number-lines option.
+func numberLinesOption(value string) (string, error) {
+	if value == "" {
+		return "1", nil
+	}
+	if _, err := strconv.Atoi(value); err != nil {
+		return "", fmt.Errorf("invalid literal for int(): %q", value)
+	}
+	return value, nil
+}
+
+// codeBlock creates a LiteralBlockNode from the content of a code directive. If a language is given, the content is split
+// into classified nodes using the configured highlighter. The tabs of the content are expanded with the tab-width option if
+// it is positive, or kept if it is negative.
+func codeBlock(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+	var language string
+	if len(d.arguments) > 0 {
+		language = d.arguments[0]
+	}
+	text := strings.Join(d.content, "\n")
+	tabWidth := 0
+	if d.hasOption("tab-width") {
+		tabWidth, _ = strconv.Atoi(d.options["tab-width"])
+	}
+	if p.Config.KeepLiteralTabs || tabWidth != 0 {
+		// The text is taken from the input before tabs were expanded, a negative tab width keeps the tabs
+		text = p.literalText(text, d.contentLine, len(d.content), d.startPosition+d.indent-1, tabWidth)
+	}
+	lb, err := p.codeLiteralBlock(d, text, language, d.contentLine, d.startPosition+d.indent)
+	if err != nil {
+		return nil, err
+	}
+	return doc.NodeList{lb}, nil
+}
+
+// codeLiteralBlock creates a LiteralBlockNode with the "code" class containing text highlighted as language. The class,
+// name, and number-lines options of the directive block are applied to the literal block.
+func (p *Parser) codeLiteralBlock(d *directiveBlock, text, language string, line, startPosition int) (*doc.LiteralBlockNode, error) {
+	lb := doc.NewLiteralBlock(p.lex.Locate(&tok.Item{
+		Text:          text,
+		Length:        utf8.RuneCountInString(text),
+		Line:          line,
+		StartPosition: startPosition,
+	}))
+	lb.Classes = []string{"code"}
+	if language != "" {
+		lb.Language = language
+		lb.Classes = append(lb.Classes, lb.Language)
+	}
+	lb.Classes = append(lb.Classes, d.classes()...)
+	lb.Names = d.names()
+
+	toks, err := p.highlight(lb.Language, text)
+	if err != nil {
+		return nil, newDirectiveError(mes.DirectiveWarningCodeLanguageUnsupported, lb.Language)
+	}
+	if d.hasOption("number-lines") {
+		start, _ := strconv.Atoi(d.options["number-lines"])
+		toks = numberLines(toks, start, start+strings.Count(text, "\n"))
+	}
+	lb.NodeList = highlightNodes(toks)
+	return lb, nil
+}
+
+// codeRole returns a role that creates an InlineLiteralNode with the "code" class followed by the language and classes.
+// If language is not empty, the text is highlighted using the configured highlighter.
+func codeRole(language string, classes ...string) roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		l := doc.NewInlineLiteral(roleItem(n))
+		l.Classes = []string{"code"}
+		if language != "" {
+			l.Classes = append(l.Classes, language)
+		}
+		l.Classes = append(l.Classes, classes...)
+		if toks, err := p.highlight(language, n.Text); err == nil {
+			l.NodeList = highlightNodes(toks)
+		}
+		return doc.NodeList{l}
+	}
+}
+
+// highlight splits code into tokens with the configured highlighter. If language is empty, the code is returned as a
+// single unclassified token.
+func (p *Parser) highlight(language, code string) ([]highlight.Token, error) {
+	if language == "" {
+		return []highlight.Token{{Class: highlight.Text, Text: code}}, nil
+	}
+	return p.Config.highlighter().Highlight(language, code)
+}
+
+// numberLines splits toks at line endings and inserts a token with the "ln" class containing the line number at the start
+// of each line. The line numbers are right aligned to the width of the last line number.
+func numberLines(toks []highlight.Token, start, end int) []highlight.Token {
+	format := fmt.Sprintf("%%%dd ", len(strconv.Itoa(end)))
+	line := start
+	out := []highlight.Token{{Class: "ln", Text: fmt.Sprintf(format, line)}}
+	for _, t := range toks {
+		parts := strings.SplitAfter(t.Text, "\n")
+		for i, part := range parts {
+			if part != "" {
+				out = append(out, highlight.Token{Class: t.Class, Text: part})
+			}
+			if i < len(parts)-1 {
+				line++
+				out = append(out, highlight.Token{Class: "ln", Text: fmt.Sprintf(format, line)})
+			}
+		}
+	}
+	return out
+}
+
+// highlightNodes converts highlighted tokens to nodes. Unclassified tokens become TextNodes and classified tokens become
+// InlineNodes with the class names of the token. If there are no classified tokens, nil is returned since the node text is
+// sufficient.
+func highlightNodes(toks []highlight.Token) doc.NodeList {
+	var nl doc.NodeList
+	classified := false
+	for _, t := range toks {
+		if t.Class == highlight.Text {
+			nl.Append(doc.NewText(&tok.Item{Text: t.Text, Length: utf8.RuneCountInString(t.Text)}))
+			continue
+		}
+		classified = true
+		nl.Append(doc.NewInline(t.Text, strings.Fields(string(t.Class))...))
+	}
+	if !classified {
+		return nil
+	}
+	return nl
+}
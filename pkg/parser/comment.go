@@ -12,6 +12,7 @@ func (p *Parser) comment(i *tok.Item) doc.Node {
 	if p.peek(1).Type == tok.BlankLine {
 		p.Msg("Found empty comment block")
 		n := doc.NewComment(&tok.Item{StartPosition: i.StartPosition, Line: i.Line})
+		n.Span = doc.NewSpan(i)
 		p.nodeTarget.Append(n)
 		return n
 	}
@@ -36,6 +37,7 @@ func (p *Parser) comment(i *tok.Item) doc.Node {
 			p.next(2)
 			for {
 				nPara.Text += "\n" + p.token.Text
+				extendItem(nPara, p.token)
 				if p.peek(1).Type == tok.Space && p.peek(2).Type == tok.Text {
 					p.next(2)
 				} else {
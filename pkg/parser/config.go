@@ -0,0 +1,65 @@
+package parser
+
+import (
+	"io/fs"
+
+	"github.com/demizer/go-rst/pkg/highlight"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// Config contains settings that change how the parser handles a document. The zero value uses the defaults.
+type Config struct {
+	// Highlighter splits the content of code directives and code roles into classified tokens. If nil, highlight.Default
+	// is used.
+	Highlighter highlight.Highlighter
+
+	// ImageResolver resolves the URIs of image and figure directives and reads image sizes when the scale option is used.
+	// If nil, URIs are used unchanged and image sizes are not read.
+	ImageResolver ImageResolver
+
+	// FS is the file system used to read files included by directives, such as the include directive. Paths are resolved
+	// relative to the root of FS, or to the directory of the including file for nested includes. If nil, file inclusion is
+	// disabled and directives reading files generate a warning. Services parsing untrusted input should leave FS nil or
+	// use a file system limited to the allowed files.
+	FS fs.FS
+
+	// DisableRaw disables the raw directive and roles derived from the raw role. Raw content is passed unchanged to the
+	// output, so services rendering untrusted input to HTML should disable it.
+	DisableRaw bool
+
+	// DocTitle enables the document title transform. A lone section at the top of the document is promoted to the
+	// document, its title becoming the document title. A lone subsection at the top of the promoted section is then
+	// promoted to the document subtitle. This is the doctitle_xform setting of docutils.
+	DocTitle bool
+
+	// TabWidth is the number of columns between tab stops used to expand the tabs in the input to spaces. If zero,
+	// tok.DefaultTabWidth is used. The input is normalized before parsing begins, so TabWidth is only used if it is set
+	// in the Config passed to NewParserWithConfig. This is the tab_width setting of docutils.
+	TabWidth int
+
+	// KeepLiteralTabs keeps the tabs, form feeds and vertical tabs in the text of literal blocks and code directives. They
+	// are otherwise converted to spaces like the rest of the input. A code directive can also keep its text with a
+	// negative tab-width option.
+	KeepLiteralTabs bool
+
+	// InputEncoding is the encoding of the input given to NewParserFromBytes and of the files read by directives without an
+	// encoding option. If empty, the encoding is detected from a byte order mark or a "-*- coding: name -*-" declaration in
+	// the first two lines of the input, and is UTF-8 otherwise. This is the input_encoding setting of docutils.
+	InputEncoding string
+}
+
+// highlighter returns the configured Highlighter or highlight.Default.
+func (c Config) highlighter() highlight.Highlighter {
+	if c.Highlighter == nil {
+		return highlight.Default
+	}
+	return c.Highlighter
+}
+
+// tabWidth returns the configured TabWidth or tok.DefaultTabWidth.
+func (c Config) tabWidth() int {
+	if c.TabWidth < 1 {
+		return tok.DefaultTabWidth
+	}
+	return c.TabWidth
+}
@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	registerDirective("contents", &directive{
+		optionalArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec: map[string]optionFunc{
+			"depth":     nonNegativeIntOption,
+			"local":     flagOption,
+			"backlinks": choiceOption("top", "entry", "none"),
+			"class":     classOption,
+		},
+		run: contentsDirective,
+	})
+}
+
+// nonNegativeIntOption validates an integer that is zero or greater.
+func nonNegativeIntOption(value string) (string, error) {
+	v := strings.TrimSpace(value)
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return "", errors.New("invalid literal for int(): " + strconv.Quote(v))
+	}
+	if n < 0 {
+		return "", errors.New("negative value; must be positive or zero")
+	}
+	return v, nil
+}
+
+// contents contains the settings of a contents directive used by the table of contents transform.
+type contents struct {
+	topic     *doc.TopicNode
+	start     *doc.SectionNode // The section containing the topic if the local option is used
+	depth     int              // The number of section levels to include
+	level     int              // The section level of the first entries
+	backlinks string
+}
+
+// contentsDirective creates a TopicNode for the table of contents. The entries of the table of contents are added by a
+// transform after the document is parsed since the sections following the directive are not known yet. The title is
+// "Contents" unless a title is given as the argument or the local option is used.
+func contentsDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	c := &contents{
+		topic:     doc.NewTopic(p.directiveItem(d)),
+		depth:     math.MaxInt32,
+		backlinks: "entry",
+	}
+	name := "Contents"
+	if len(d.arguments) > 0 {
+		c.topic.Title = doc.NewTitleNode()
+		c.topic.Title.Line = d.line
+		c.topic.Title.StartPosition = d.argPosition
+		c.topic.Title.NodeList = p.parseInline(d.arguments[0], d.line, d.argPosition)
+		c.topic.Title.Length = len([]rune(d.arguments[0]))
+		name = c.topic.Title.NodeList.Text()
+	} else if !d.hasOption("local") {
+		c.topic.Title = doc.NewTitleNodeWithText(&tok.Item{Text: name, Length: len(name), Line: d.line})
+	}
+	c.topic.Classes = append([]string{"contents"}, d.classes()...)
+	if d.hasOption("local") {
+		c.topic.Classes = append(c.topic.Classes, "local")
+		c.start = p.sectionLevels.lastSectionNode
+	}
+	c.topic.Names = []string{doc.NormalizeReferenceName(name)}
+	if d.hasOption("depth") {
+		c.depth, _ = strconv.Atoi(d.options["depth"])
+	}
+	if d.hasOption("backlinks") {
+		c.backlinks = d.options["backlinks"]
+	}
+
+	p.addTransform(transformPriorityContents, c.apply)
+	return doc.NodeList{c.topic}, nil
+}
+
+// apply builds the table of contents from the sections of the document, or from the subsections of the section
+// containing the topic if the local option is used. If there are no sections, the topic is removed from the document.
+func (c *contents) apply(p *Parser) {
+	nodes := *p.Nodes
+	c.level = 1
+	if c.start != nil {
+		nodes = c.start.NodeList
+		c.level = c.start.Level + 1
+	} else {
+		// Sections promoted to the document title and subtitle are not part of the table of contents
+		for _, n := range nodes {
+			if sec, ok := n.(*doc.SectionNode); ok {
+				c.level = sec.Level
+				break
+			}
+		}
+	}
+	if list := c.entries(p, nodes, c.level); list != nil {
+		c.topic.NodeList = doc.NodeList{list}
+		return
+	}
+	p.Nodes.Remove(c.topic)
+}
+
+// entries returns a bullet list containing a reference to each section of level in nodes. Subsections are added as
+// nested lists until the depth of the table of contents is reached. Nil is returned if nodes contains no sections.
+func (c *contents) entries(p *Parser, nodes doc.NodeList, level int) *doc.BulletListNode {
+	var list *doc.BulletListNode
+	for _, n := range nodes {
+		sec, ok := n.(*doc.SectionNode)
+		if !ok || sec.Level != level {
+			continue
+		}
+		ref := doc.NewReference(sec.IDs[0], sec.Title.NodeList)
+		ref.ID = p.newID("", "toc-entry")
+		if sec.Title.RefID == "" {
+			switch c.backlinks {
+			case "entry":
+				sec.Title.RefID = ref.ID
+			case "top":
+				sec.Title.RefID = c.topic.IDs[0]
+			}
+		}
+		item := doc.NewBulletListItemNode(nil)
+		para := doc.NewParagraph()
+		para.Append(ref)
+		item.Append(para)
+		if level-c.levelOffset() < c.depth {
+			if sub := c.entries(p, sec.NodeList, level+1); sub != nil {
+				item.Append(sub)
+			}
+		}
+		if list == nil {
+			list = doc.NewBulletListNode(&tok.Item{Text: "*"})
+		}
+		list.Append(item)
+	}
+	return list
+}
+
+// levelOffset returns the number of section levels above the first level of the table of contents.
+func (c *contents) levelOffset() int { return c.level - 1 }
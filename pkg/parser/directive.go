@@ -0,0 +1,407 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// directive describes the arguments, options, and content accepted by a directive. It is modeled after the docutils
+// Directive class.
+type directive struct {
+	requiredArguments       int                   // Number of required directive arguments
+	optionalArguments       int                   // Number of optional arguments after the required arguments
+	finalArgumentWhitespace bool                  // May the final argument contain whitespace?
+	optionSpec              map[string]optionFunc // Option names mapped to validation functions
+	hasContent              bool                  // May the directive have content?
+
+	// run is called with the parsed directive block and returns the nodes generated by the directive. If a directiveError
+	// is returned, a system message of the error type is generated. Other errors generate an "Error in directive" system
+	// message using the error text.
+	run func(p *Parser, d *directiveBlock) (doc.NodeList, error)
+}
+
+// directiveError is returned by directives to report a problem with a specific system message.
+type directiveError struct {
+	typ  mes.MessageType
+	args []interface{}
+}
+
+func (e *directiveError) Error() string {
+	m := mes.NewParserMessage(e.typ)
+	m.Args = e.args
+	return m.Message()
+}
+
+// newDirectiveError returns a directiveError of message type t. args are substituted into the message.
+func newDirectiveError(t mes.MessageType, args ...interface{}) error {
+	return &directiveError{typ: t, args: args}
+}
+
+// directives contains the directives known to the parser by directive type name. Directives are added to the map using
+// registerDirective from the init function of the file implementing the directive.
+var directives = make(map[string]*directive)
+
+func registerDirective(name string, d *directive) { directives[name] = d }
+
+// directiveBlock contains a directive split into its arguments, options, and content.
+type directiveBlock struct {
+	name          string            // The directive type
+	arguments     []string          // Parsed directive arguments
+	options       map[string]string // Validated directive options
+	content       []string          // Content lines with the block indentation removed
+	contentLine   int               // The line number of the first content line
+	indent        int               // The indentation of the directive block
+	line          int               // The line of the explicit markup start
+	endLine       int               // The last line of the directive block
+	startPosition int               // The start position of the explicit markup start
+	argPosition   int               // The start position of the text following the directive type
+	text          string            // The text of the directive as it appears in the input
+}
+
+// hasOption returns true if the option was given in the directive block.
+func (d *directiveBlock) hasOption(name string) bool {
+	_, ok := d.options[name]
+	return ok
+}
+
+// directiveItem returns a located token item at the explicit markup start of the directive block d spanning the text of
+// the directive.
+func (p *Parser) directiveItem(d *directiveBlock) *tok.Item {
+	return p.lex.Locate(&tok.Item{Text: d.text, Length: utf8.RuneCountInString(d.text), Line: d.line,
+		StartPosition: d.startPosition})
+}
+
+// fieldMarker matches the start of an option line in a directive block.
+var fieldMarker = regexp.MustCompile(`^:((?:[^:\\]|\\.|:[^ :\x60])+?):(?: +|$)`)
+
+// directiveLine is a line from a directive block.
+type directiveLine struct {
+	line int
+	text string
+}
+
+// directive parses a directive beginning at the DirectiveMark token i. The directive is looked up by type name and the
+// directive block is split into arguments, options, and content before the directive is run. Nodes returned by the directive
+// are appended to the current node target.
+func (p *Parser) directive(i *tok.Item) {
+	d := &directiveBlock{line: i.Line, endLine: i.Line, startPosition: i.StartPosition}
+	var firstLine string
+	var block []directiveLine
+
+	// Gather the first line of the directive
+	for {
+		ni := p.peek(1)
+		if ni == nil || ni.Line != i.Line {
+			break
+		}
+		p.next(1)
+		switch ni.Type {
+		case tok.DirectiveType:
+			d.name = ni.Text
+		case tok.DirectiveArgument:
+			firstLine = ni.Text
+			d.argPosition = ni.StartPosition
+		}
+	}
+
+	// Gather the indented directive block. Blank lines are only part of the block if they are followed by more of the
+	// block.
+	for {
+		x := 1
+		for pi := p.peek(x); pi != nil && pi.Type == tok.BlankLine; pi = p.peek(x) {
+			x++
+		}
+		sp, db := p.peek(x), p.peek(x+1)
+		if sp == nil || db == nil || sp.Type != tok.Space || db.Type != tok.DirectiveBlock {
+			break
+		}
+		for y := 1; y < x; y++ {
+			block = append(block, directiveLine{line: p.next(1).Line})
+		}
+		d.indent = p.next(1).Length
+		block = append(block, directiveLine{line: p.next(1).Line, text: p.token.Text})
+		d.endLine = p.token.Line
+	}
+
+	d.text = p.inputText(d.line, d.endLine)
+
+	// Directive type names are case insensitive
+	dir, ok := directives[strings.ToLower(d.name)]
+	if !ok {
+		p.Msgr("Unknown directive", "name", d.name)
+		p.directiveMessage(mes.DirectiveErrorUnknownDirectiveType, d, d.name)
+		return
+	}
+	d.name = strings.ToLower(d.name)
+
+	if err := dir.parseDirectiveBlock(d, firstLine, block); err != nil {
+		p.directiveMessage(mes.DirectiveErrorInvalidDirective, d, d.name, err.Error())
+		return
+	}
+
+	nodes, err := dir.run(p, d)
+	if de, ok := err.(*directiveError); ok {
+		p.directiveMessage(de.typ, d, de.args...)
+		return
+	} else if err != nil {
+		p.directiveMessage(mes.DirectiveErrorInvalidDirective, d, d.name, err.Error())
+		return
+	}
+
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	p.nodeTarget.Append(nodes...)
+}
+
+// parseDirectiveBlock splits the directive block into arguments, options, and content according to the directive
+// specification. The first line is the text following the directive type on the line of the explicit markup start. This
+// is a port of docutils' Body.parse_directive_block.
+func (dir *directive) parseDirectiveBlock(d *directiveBlock, firstLine string, block []directiveLine) error {
+	lines := block
+	if strings.TrimSpace(firstLine) != "" {
+		lines = append([]directiveLine{{line: d.line, text: firstLine}}, block...)
+	}
+
+	var argBlock, content []directiveLine
+	var end int // The index of the first line after the argument block
+	if dir.requiredArguments > 0 || dir.optionalArguments > 0 || dir.optionSpec != nil {
+		for end < len(lines) && strings.TrimSpace(lines[end].text) != "" {
+			end++
+		}
+		argBlock = lines[:end]
+		content = lines[end:]
+	} else {
+		content = lines
+	}
+
+	d.options = make(map[string]string)
+	if dir.optionSpec != nil {
+		for i, l := range argBlock {
+			if fieldMarker.MatchString(l.text) {
+				if err := dir.parseDirectiveOptions(d, argBlock[i:]); err != nil {
+					return err
+				}
+				argBlock = argBlock[:i]
+				break
+			}
+		}
+	}
+
+	if len(argBlock) > 0 && dir.requiredArguments == 0 && dir.optionalArguments == 0 {
+		content = append(append([]directiveLine{}, argBlock...), lines[end:]...)
+		argBlock = nil
+	}
+
+	for len(content) > 0 && strings.TrimSpace(content[0].text) == "" {
+		content = content[1:]
+	}
+
+	if dir.requiredArguments > 0 || dir.optionalArguments > 0 {
+		if err := dir.parseDirectiveArguments(d, argBlock); err != nil {
+			return err
+		}
+	}
+
+	if len(content) > 0 && !dir.hasContent {
+		return fmt.Errorf("no content permitted")
+	}
+
+	for _, l := range content {
+		d.content = append(d.content, l.text)
+	}
+	if len(content) > 0 {
+		d.contentLine = content[0].line
+	}
+
+	return nil
+}
+
+// parseDirectiveArguments splits the argument block into the directive arguments.
+func (dir *directive) parseDirectiveArguments(d *directiveBlock, argBlock []directiveLine) error {
+	var text []string
+	for _, l := range argBlock {
+		text = append(text, l.text)
+	}
+	argText := strings.Join(text, "\n")
+	args := strings.Fields(argText)
+	maxArgs := dir.requiredArguments + dir.optionalArguments
+
+	if len(args) < dir.requiredArguments {
+		return fmt.Errorf("%d argument(s) required, %d supplied", dir.requiredArguments, len(args))
+	} else if len(args) > maxArgs {
+		if !dir.finalArgumentWhitespace {
+			return fmt.Errorf("maximum %d argument(s) allowed, %d supplied", maxArgs, len(args))
+		}
+		// Split the text into at most maxArgs arguments, the last argument keeps the remaining text
+		args = args[:0]
+		rest := strings.TrimSpace(argText)
+		for len(args) < maxArgs-1 {
+			f := strings.Fields(rest)[0]
+			args = append(args, f)
+			rest = strings.TrimSpace(rest[len(f):])
+		}
+		args = append(args, rest)
+	}
+
+	d.arguments = args
+	return nil
+}
+
+// parseDirectiveOptions parses the option lines of a directive block. Each option begins with a field marker, i.e.,
+// ":name:", and may continue on following indented lines.
+func (dir *directive) parseDirectiveOptions(d *directiveBlock, optBlock []directiveLine) error {
+	var names []string
+	values := make(map[string]string)
+	for _, l := range optBlock {
+		m := fieldMarker.FindStringSubmatch(l.text)
+		if m == nil {
+			if len(names) == 0 || !strings.HasPrefix(l.text, " ") {
+				return errors.New("invalid option block")
+			}
+			last := names[len(names)-1]
+			values[last] = strings.TrimSpace(values[last] + "\n" + strings.TrimSpace(l.text))
+			continue
+		}
+		name := strings.ToLower(m[1])
+		if _, ok := values[name]; ok {
+			return fmt.Errorf("invalid option data: duplicate option \"%s\"", name)
+		}
+		names = append(names, name)
+		values[name] = strings.TrimSpace(l.text[len(m[0]):])
+	}
+
+	for _, name := range names {
+		conv, ok := dir.optionSpec[name]
+		if !ok {
+			return fmt.Errorf("unknown option: \"%s\"", name)
+		}
+		val, err := conv(values[name])
+		if err != nil {
+			return fmt.Errorf("invalid option value: (option: \"%s\"; value: %q)\n%s", name, values[name], err)
+		}
+		d.options[name] = val
+	}
+
+	return nil
+}
+
+// inputText returns the text of the input from line start to line end. Line numbers are those of the tokens emitted by the
+// lexer.
+func (p *Parser) inputText(start, end int) string {
+	lines := strings.Split(p.text, "\n")
+	start, end = start-p.lex.LineOffset, end-p.lex.LineOffset
+	if start < 1 || end > len(lines) || start > end {
+		return ""
+	}
+	return strings.Join(lines[start-1:end], "\n")
+}
+
+// subParse parses lines as a separate document fragment and returns the parsed nodes. line is the line number of the first
+// line in the original input and indent is the number of columns the lines were indented in the original input, these are
+// used to keep the positions of the parsed nodes relative to the original input. System messages generated by the sub
+// parser are added to p.Messages.
+func (p *Parser) subParse(lines []string, line, indent int) doc.NodeList {
+	return p.subParseText(strings.Join(lines, "\n"), line, indent, false)
+}
+
+// subParseText parses text like subParse. If inline is true the text can only contain inline markup and a literal block
+// marker at the end of the text is not removed.
+func (p *Parser) subParseText(text string, line, indent int, inline bool) doc.NodeList {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	sp, err := NewParserWithConfig(p.Name, text, p.Config, p.logConf)
+	if err != nil {
+		p.Err(err)
+		return nil
+	}
+	sp.source = p.source
+	sp.subParser = true
+	sp.inline = inline
+	sp.nested = true
+	sp.includes = p.includes
+	sp.roles = p.roles
+	sp.blockQuotes = p.blockQuotes
+	sp.lex.LineOffset = line - 1
+	sp.lex.PositionOffset = indent
+	sp.lex.Within(p.lex)
+	sp.Parse()
+	p.Messages.Append(*sp.Messages...)
+	p.transforms = append(p.transforms, sp.transforms...)
+	return *sp.Nodes
+}
+
+// parseInline parses text as a paragraph and returns the inline nodes of the paragraph. It is used for text that can only
+// contain inline markup, such as admonition titles.
+func (p *Parser) parseInline(text string, line, startPosition int) doc.NodeList {
+	nodes := p.subParseText(text, line, startPosition-1, true)
+	if len(nodes) == 0 {
+		return nil
+	}
+	if pn, ok := nodes[0].(*doc.ParagraphNode); ok {
+		return pn.NodeList
+	}
+	return nodes
+}
+
+// optionFunc validates and converts the value of a directive option. An error is returned if the value is invalid.
+type optionFunc func(value string) (string, error)
+
+// flagOption is used for options that take no value.
+func flagOption(value string) (string, error) {
+	if strings.TrimSpace(value) != "" {
+		return "", fmt.Errorf("no argument is allowed; %q supplied", value)
+	}
+	return "", nil
+}
+
+// unchangedOption returns the value unchanged. An empty value is allowed.
+func unchangedOption(value string) (string, error) { return value, nil }
+
+// unchangedRequiredOption returns the value unchanged. An error is returned if the value is empty.
+func unchangedRequiredOption(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("argument required but none supplied")
+	}
+	return value, nil
+}
+
+// classOption converts a space separated list of class names into identifiers using doc.MakeID. The names are returned
+// separated by a single space.
+func classOption(value string) (string, error) {
+	if value == "" {
+		return "", errors.New("argument required but none supplied")
+	}
+	var names []string
+	for _, name := range strings.Fields(value) {
+		id := doc.MakeID(name)
+		if id == "" {
+			return "", fmt.Errorf("cannot make %q into a class name", name)
+		}
+		names = append(names, id)
+	}
+	return strings.Join(names, " "), nil
+}
+
+// classes returns the class names given by the "class" option of the directive block.
+func (d *directiveBlock) classes() []string { return strings.Fields(d.options["class"]) }
+
+// names returns the normalized name given by the "name" option of the directive block.
+func (d *directiveBlock) names() []string {
+	if name, ok := d.options["name"]; ok {
+		return []string{doc.NormalizeReferenceName(name)}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package parser
+
+import (
+	doc "github.com/demizer/go-rst/pkg/document"
+)
+
+// docTitle is the document title transform. If the document contains a single section and no other body elements, the
+// section is promoted to the document: its title becomes the document title and its elements replace it. If the title
+// was promoted and the promoted elements contain a single subsection and no other body elements, the subsection title
+// becomes the document subtitle in the same way. This is the DocTitle transform of docutils.
+func docTitle(p *Parser) {
+	d := p.Document
+	sec := loneSection(d.NodeList)
+	if sec == nil {
+		return
+	}
+	d.Title = sec.Title
+	d.IDs = append(d.IDs, sec.IDs...)
+	d.Names = append(d.Names, sec.Names...)
+	d.Classes = append(d.Classes, sec.Classes...)
+	promoteSection(d, sec)
+	if sub := loneSection(d.NodeList); sub != nil {
+		d.Subtitle = sub.Title
+		d.Subtitle.IDs = sub.IDs
+		d.Subtitle.Names = sub.Names
+		promoteSection(d, sub)
+	}
+}
+
+// loneSection returns the section in nl if it is the only element of nl other than comments, targets, system messages
+// and other elements not shown in the document body. This is candidate_index of the docutils DocTitle transform.
+func loneSection(nl doc.NodeList) *doc.SectionNode {
+	for i, n := range nl {
+		switch n.(type) {
+		case *doc.CommentNode, *doc.SystemMessageNode, *doc.PendingNode, *doc.HyperlinkTargetNode, *doc.MetaNode,
+			*doc.DocumentTitleNode:
+			continue
+		}
+		if sec, ok := n.(*doc.SectionNode); ok && i == len(nl)-1 {
+			return sec
+		}
+		return nil
+	}
+	return nil
+}
+
+// promoteSection replaces sec in the document with the elements of sec.
+func promoteSection(d *doc.DocumentNode, sec *doc.SectionNode) {
+	nl := append(doc.NodeList{}, d.NodeList[:len(d.NodeList)-1]...)
+	d.NodeList = append(nl, sec.NodeList...)
+}
@@ -0,0 +1,55 @@
+package parser
+
+import (
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestDocTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		disable  bool
+		title    string
+		subtitle string
+		nodes    int // The number of nodes in the document after the transform
+	}{
+		{"title and subtitle", ".. comment\n\nTitle\n=====\n\nSubtitle\n--------\n\nText.\n\nSection\n-------\n\nText.",
+			false, "Title", "", 3},
+		{"title and lone subtitle", "=====\nTitle\n=====\n\nSubtitle\n--------\n\nText.", false, "Title", "Subtitle", 1},
+		{"text before section", "Text.\n\nTitle\n=====\n\nText.", false, "", "", 2},
+		{"two sections", "One\n===\n\nText.\n\nTwo\n===\n\nText.", false, "", "", 2},
+		{"disabled", "Title\n=====\n\nText.", true, "", "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewParser("test", tt.input, testutil.LoggerConfig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p.Config.DocTitle = !tt.disable
+			p.Parse()
+			var title, subtitle string
+			if p.Document.Title != nil {
+				title = p.Document.Title.NodeList.Text()
+			}
+			if p.Document.Subtitle != nil {
+				subtitle = p.Document.Subtitle.NodeList.Text()
+			}
+			if title != tt.title || subtitle != tt.subtitle {
+				t.Errorf("got title %q and subtitle %q, expect %q and %q", title, subtitle, tt.title, tt.subtitle)
+			}
+			if len(*p.Nodes) != tt.nodes {
+				t.Errorf("got %d nodes, expect %d", len(*p.Nodes), tt.nodes)
+			}
+			if tt.title != "" && p.Document.IDs[0] != "title" {
+				t.Errorf("got document ids %v, expect [title]", p.Document.IDs)
+			}
+			if _, ok := (*p.Nodes)[0].(*doc.CommentNode); tt.name == "title and subtitle" && !ok {
+				t.Errorf("expect the comment before the title to remain the first node")
+			}
+		})
+	}
+}
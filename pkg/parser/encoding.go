@@ -0,0 +1,257 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// textEncoding is an encoding of the input. name is the name of the encoding used in error messages. The input is converted
+// to UTF-8 by enc, input in UTF-8 or ASCII is used unchanged if enc is nil. invalid returns the offset of the first byte of
+// its argument that is not valid in the encoding, or -1 if all bytes are valid.
+type textEncoding struct {
+	name    string
+	enc     encoding.Encoding
+	invalid func([]byte) int
+}
+
+var (
+	utf8Encoding    = &textEncoding{name: "utf-8", invalid: utf8Invalid}
+	asciiEncoding   = &textEncoding{name: "ascii", invalid: asciiInvalid}
+	utf16LEEncoding = &textEncoding{name: "utf-16-le", enc: unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+		invalid: utf16Invalid(binary.LittleEndian)}
+	utf16BEEncoding = &textEncoding{name: "utf-16-be", enc: unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+		invalid: utf16Invalid(binary.BigEndian)}
+)
+
+// encodingNames maps the names of encodings to the encodings that are not found by the name of a charmap. The names are
+// normalized by normalizeEncodingName.
+var encodingNames = map[string]*textEncoding{
+	"utf-8":     utf8Encoding,
+	"utf8":      utf8Encoding,
+	"u8":        utf8Encoding,
+	"utf-8-sig": utf8Encoding,
+	"ascii":     asciiEncoding,
+	"us-ascii":  asciiEncoding,
+	"utf-16-le": utf16LEEncoding,
+	"utf-16le":  utf16LEEncoding,
+	"utf-16-be": utf16BEEncoding,
+	"utf-16be":  utf16BEEncoding,
+}
+
+// charmapNames maps Python codec names that differ from the charmap names to the charmap names. The names are normalized by
+// charmapKey.
+var charmapNames = map[string]string{
+	"latin1": "iso88591",
+	"l1":     "iso88591",
+	"cp819":  "iso88591",
+	"latin9": "iso885915",
+	"l9":     "iso885915",
+	"mac":    "macintosh",
+}
+
+// codingCookie matches an encoding declaration such as "-*- coding: latin-1 -*-" in the first two lines of the input. This
+// is the coding_slug of docutils.
+var codingCookie = regexp.MustCompile(`coding[:=]\s*([-\w.]+)`)
+
+// normalizeEncodingName returns name in lower case with underscores and spaces converted to hyphens.
+func normalizeEncodingName(name string) string {
+	return strings.NewReplacer("_", "-", " ", "-").Replace(strings.ToLower(strings.TrimSpace(name)))
+}
+
+// charmapKey returns name in lower case without the characters that are not letters or digits.
+func charmapKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return -1
+	}, name)
+}
+
+// lookupEncoding returns the encoding with the name name. The names of Python codecs, such as "latin-1" and "cp1252", and
+// the names of the charmaps of golang.org/x/text are accepted. The byte order of "utf-16" is taken from the byte order mark
+// at the start of input, without a byte order mark the input is little endian.
+func lookupEncoding(name string, input []byte) (*textEncoding, error) {
+	n := normalizeEncodingName(name)
+	if e, ok := encodingNames[n]; ok {
+		return e, nil
+	}
+	if n == "utf-16" || n == "utf16" {
+		if bytes.HasPrefix(input, []byte{0xfe, 0xff}) {
+			return utf16BEEncoding, nil
+		}
+		return utf16LEEncoding, nil
+	}
+	key := charmapKey(n)
+	if k, ok := charmapNames[key]; ok {
+		key = k
+	}
+	keys := []string{key}
+	if cp := strings.TrimPrefix(key, "cp"); cp != key {
+		// The charmaps of the code pages are named "Windows 1252" and "IBM Code Page 437"
+		keys = append(keys, "windows"+cp, "ibmcodepage"+cp)
+	}
+	for _, e := range charmap.All {
+		cm, ok := e.(*charmap.Charmap)
+		if !ok {
+			continue
+		}
+		for _, k := range keys {
+			if charmapKey(cm.String()) == k {
+				return &textEncoding{name: n, enc: cm, invalid: charmapInvalid(cm)}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("unknown encoding: %q", strings.TrimSpace(name))
+}
+
+// detectEncoding returns the encoding of input given by a byte order mark or a coding cookie in the first two lines. UTF-8
+// is returned if the input does not declare an encoding.
+func detectEncoding(input []byte) (*textEncoding, error) {
+	switch {
+	case bytes.HasPrefix(input, []byte("\ufeff")):
+		return utf8Encoding, nil
+	case bytes.HasPrefix(input, []byte{0xff, 0xfe}):
+		return utf16LEEncoding, nil
+	case bytes.HasPrefix(input, []byte{0xfe, 0xff}):
+		return utf16BEEncoding, nil
+	}
+	lines := bytes.SplitN(input, []byte("\n"), 3)
+	for _, l := range lines[:min(len(lines), 2)] {
+		if m := codingCookie.FindSubmatch(l); m != nil {
+			return lookupEncoding(string(m[1]), input)
+		}
+	}
+	return utf8Encoding, nil
+}
+
+// DecodeError is the error returned when the input contains a byte sequence that is not valid in its encoding.
+type DecodeError struct {
+	Encoding string // The name of the encoding
+	Byte     byte   // The first byte of the invalid byte sequence
+	Offset   int    // The offset of Byte in the input
+	Line     int    // The line of Byte in the decoded input, counted from 1
+	Column   int    // The column of Byte in the decoded input, counted in runes from 1
+}
+
+// Error satisfies the error interface.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("'%s' codec can't decode byte %#x in position %d: line %d, column %d", e.Encoding, e.Byte,
+		e.Offset, e.Line, e.Column)
+}
+
+// decode converts input from the encoding named name to UTF-8. If name is empty, the encoding is detected from a
+// byte order mark or a coding cookie in the input, and is UTF-8 otherwise. A byte order mark at the start of the input is
+// removed and line endings are converted to newlines. A DecodeError is returned if the input is not valid in its encoding.
+func decode(input []byte, name string) (string, error) {
+	var e *textEncoding
+	var err error
+	if name != "" {
+		e, err = lookupEncoding(name, input)
+	} else {
+		e, err = detectEncoding(input)
+	}
+	if err != nil {
+		return "", err
+	}
+	if i := e.invalid(input); i >= 0 {
+		text, _ := e.decode(input[:i])
+		line := strings.Count(text, "\n")
+		return "", &DecodeError{Encoding: e.name, Byte: input[i], Offset: i, Line: line + 1,
+			Column: utf8.RuneCountInString(text[strings.LastIndex(text, "\n")+1:]) + 1}
+	}
+	return e.decode(input)
+}
+
+// decode converts the valid input b to UTF-8. The byte order mark is removed and line endings are converted to newlines.
+func (e *textEncoding) decode(b []byte) (string, error) {
+	if e.enc != nil {
+		var err error
+		if b, err = e.enc.NewDecoder().Bytes(b); err != nil {
+			return "", err
+		}
+	}
+	return normalizeLineEndings(strings.TrimPrefix(string(b), "\ufeff")), nil
+}
+
+// normalizeLineEndings converts the Windows "\r\n" and the old Macintosh "\r" line endings of text to "\n".
+func normalizeLineEndings(text string) string {
+	if !strings.Contains(text, "\r") {
+		return text
+	}
+	return strings.Replace(strings.Replace(text, "\r\n", "\n", -1), "\r", "\n", -1)
+}
+
+// utf8Invalid returns the offset of the first byte of b that is not part of a valid UTF-8 sequence, or -1.
+func utf8Invalid(b []byte) int {
+	if utf8.Valid(b) {
+		return -1
+	}
+	for i := 0; i < len(b); {
+		r, w := utf8.DecodeRune(b[i:])
+		if r == utf8.RuneError && w == 1 {
+			return i
+		}
+		i += w
+	}
+	return -1
+}
+
+// asciiInvalid returns the offset of the first byte of b that is not ASCII, or -1.
+func asciiInvalid(b []byte) int {
+	for i, c := range b {
+		if c > 0x7f {
+			return i
+		}
+	}
+	return -1
+}
+
+// utf16Invalid returns a function that returns the offset of the first code unit of b that is not valid UTF-16 in the byte
+// order order, or -1. Unpaired surrogates and a trailing odd byte are not valid.
+func utf16Invalid(order binary.ByteOrder) func([]byte) int {
+	return func(b []byte) int {
+		for i := 0; i < len(b); i += 2 {
+			if i+1 == len(b) {
+				return i
+			}
+			switch u := order.Uint16(b[i:]); {
+			case u >= 0xdc00 && u < 0xe000:
+				return i
+			case u >= 0xd800 && u < 0xdc00:
+				if i+3 >= len(b) {
+					return i
+				}
+				if v := order.Uint16(b[i+2:]); v < 0xdc00 || v >= 0xe000 {
+					return i
+				}
+				i += 2
+			}
+		}
+		return -1
+	}
+}
+
+// charmapInvalid returns a function that returns the offset of the first byte of b that is not defined in the charmap cm,
+// or -1.
+func charmapInvalid(cm *charmap.Charmap) func([]byte) int {
+	return func(b []byte) int {
+		for i, c := range b {
+			if cm.DecodeByte(c) == utf8.RuneError {
+				return i
+			}
+		}
+		return -1
+	}
+}
@@ -0,0 +1,102 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		encoding string
+		expect   string
+	}{
+		{"utf-8", []byte("Grüße\n"), "", "Grüße\n"},
+		{"utf-8 bom", []byte("\ufeffGrüße\n"), "", "Grüße\n"},
+		{"utf-8 bom with encoding", []byte("\ufeffGrüße\n"), "utf-8", "Grüße\n"},
+		{"utf-16 le bom", []byte{0xff, 0xfe, 'G', 0, 'r', 0, 0xfc, 0, '\n', 0}, "", "Grü\n"},
+		{"utf-16 be bom", []byte{0xfe, 0xff, 0, 'G', 0, 'r', 0, 0xfc, 0, '\n'}, "", "Grü\n"},
+		{"utf-16 surrogate pair", []byte{0xff, 0xfe, 0x3c, 0xd8, 0x89, 0xdf}, "", "🎉"},
+		{"utf-16 without bom", []byte{'G', 0, 0xfc, 0}, "UTF-16", "Gü"},
+		{"latin-1", []byte("Gr\xfc\xdfe\n"), "latin-1", "Grüße\n"},
+		{"iso-8859-15", []byte("\xa4 5\n"), "ISO_8859-15", "€ 5\n"},
+		{"cp1252", []byte("\x93quoted\x94\n"), "cp1252", "“quoted”\n"},
+		{"coding cookie", []byte(".. -*- coding: latin-1 -*-\n\nGr\xfc\xdfe\n"), "",
+			".. -*- coding: latin-1 -*-\n\nGrüße\n"},
+		{"coding cookie on second line", []byte("..\n   vim: set fileencoding=latin-1 :\n\xfc\n"), "",
+			"..\n   vim: set fileencoding=latin-1 :\nü\n"},
+		{"encoding overrides cookie", []byte(".. coding: latin-1\n\xc3\xbc\n"), "utf-8", ".. coding: latin-1\nü\n"},
+		{"crlf", []byte("One\r\nTwo\r\n"), "", "One\nTwo\n"},
+		{"cr", []byte("One\rTwo\r"), "", "One\nTwo\n"},
+		{"utf-16 crlf", []byte{0xff, 0xfe, 'a', 0, '\r', 0, '\n', 0, 'b', 0}, "", "a\nb"},
+	}
+	for _, tt := range tests {
+		got, err := decode(tt.input, tt.encoding)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.name, err)
+		} else if got != tt.expect {
+			t.Errorf("%s: got %q, expect %q", tt.name, got, tt.expect)
+		}
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		encoding string
+		expect   DecodeError
+	}{
+		{"utf-8", []byte("Title\r\n=====\r\n\r\nGrüße \xff\n"), "",
+			DecodeError{Encoding: "utf-8", Byte: 0xff, Offset: 24, Line: 4, Column: 7}},
+		{"ascii", []byte("ok\nnot ok \xe9"), "ascii", DecodeError{Encoding: "ascii", Byte: 0xe9, Offset: 10, Line: 2, Column: 8}},
+		{"utf-16 unpaired surrogate", []byte{0xff, 0xfe, 'a', 0, '\n', 0, 'b', 0, 0x3c, 0xd8, 'c', 0}, "",
+			DecodeError{Encoding: "utf-16-le", Byte: 0x3c, Offset: 8, Line: 2, Column: 2}},
+		{"utf-16 odd length", []byte{0, 'a', 0}, "utf-16-be", DecodeError{Encoding: "utf-16-be", Byte: 0, Offset: 2, Line: 1,
+			Column: 2}},
+	}
+	for _, tt := range tests {
+		_, err := decode(tt.input, tt.encoding)
+		var de *DecodeError
+		if !errors.As(err, &de) {
+			t.Errorf("%s: got error %v, expect a DecodeError", tt.name, err)
+		} else if *de != tt.expect {
+			t.Errorf("%s: got %+v, expect %+v", tt.name, *de, tt.expect)
+		}
+	}
+	if _, err := decode([]byte("text"), "klingon"); err == nil || err.Error() != `unknown encoding: "klingon"` {
+		t.Errorf("got error %v, expect an unknown encoding error", err)
+	}
+}
+
+func TestNewParserFromBytes(t *testing.T) {
+	p, err := NewParserFromBytes("test", []byte("\xef\xbb\xbfTitle\r\n=====\r\n\r\nGr\xc3\xbc\xc3\x9fe.\r\n"), Config{},
+		testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Parse()
+	sec, ok := (*p.Nodes)[0].(*doc.SectionNode)
+	if !ok {
+		t.Fatalf("got %s, expect a section", (*p.Nodes)[0].NodeType())
+	}
+	if title, text := sec.Title.NodeList.Text(), sec.NodeList[0].(*doc.ParagraphNode).NodeList.Text(); title != "Title" ||
+		text != "Grüße." {
+		t.Errorf("got title %q and text %q, expect %q and %q", title, text, "Title", "Grüße.")
+	}
+	if len(*p.Messages) != 0 {
+		t.Errorf("got %d messages, expect 0", len(*p.Messages))
+	}
+	_, err = NewParserFromBytes("test", []byte("Gr\xfc\xdfe\n"), Config{}, testutil.LoggerConfig)
+	if err == nil || err.Error() != "'utf-8' codec can't decode byte 0xfc in position 2: line 1, column 3" {
+		t.Errorf("got error %v, expect a decode error", err)
+	}
+	if _, err = NewParserFromBytes("test", []byte("Gr\xfc\xdfe\n"), Config{InputEncoding: "latin-1"},
+		testutil.LoggerConfig); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
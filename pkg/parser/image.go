@@ -0,0 +1,271 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Register the GIF decoder for ImageSize
+	_ "image/jpeg" // Register the JPEG decoder for ImageSize
+	_ "image/png"  // Register the PNG decoder for ImageSize
+	"io/fs"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+)
+
+// ImageResolver is used by the image and figure directives to resolve image URIs and to read the size of images. The size
+// is only read when the scale option is used without both the width and height options.
+type ImageResolver interface {
+	// ResolveURI returns the URI used for the image in the parsed document.
+	ResolveURI(uri string) (string, error)
+
+	// ImageSize returns the width and height of the image at uri in pixels.
+	ImageSize(uri string) (width, height int, err error)
+}
+
+// FSImageResolver resolves image URIs as paths in a file system. URIs are not changed. Image sizes can be read from PNG,
+// GIF, and JPEG images.
+type FSImageResolver struct {
+	FS fs.FS
+}
+
+// ResolveURI satisfies the ImageResolver interface.
+func (r FSImageResolver) ResolveURI(uri string) (string, error) { return uri, nil }
+
+// ImageSize satisfies the ImageResolver interface.
+func (r FSImageResolver) ImageSize(uri string) (int, int, error) {
+	if strings.Contains(uri, "://") {
+		return 0, 0, errors.New("not a local file")
+	}
+	f, err := r.FS.Open(strings.TrimPrefix(path.Clean(uri), "/"))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+	c, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return 0, 0, err
+	}
+	return c.Width, c.Height, nil
+}
+
+// lengthUnits are the units allowed in length option values.
+var lengthUnits = []string{"em", "ex", "px", "in", "cm", "mm", "pt", "pc"}
+
+var measure = regexp.MustCompile(`^([0-9.]+) *([a-z%]*)$`)
+
+// measureOption returns an optionFunc validating a positive number followed by one of units. The value is returned with
+// any space between the number and unit removed.
+func measureOption(units ...string) optionFunc {
+	return func(value string) (string, error) {
+		m := measure.FindStringSubmatch(strings.TrimSpace(value))
+		valid := m != nil
+		if valid {
+			_, err := strconv.ParseFloat(m[1], 64)
+			valid = err == nil && contains(units, m[2])
+		}
+		if !valid {
+			var quoted []string
+			for _, u := range units {
+				quoted = append(quoted, strconv.Quote(u))
+			}
+			return "", fmt.Errorf("not a positive measure of one of the following units:\n%s", strings.Join(quoted, " "))
+		}
+		return m[1] + m[2], nil
+	}
+}
+
+var (
+	lengthOrUnitlessOption             = measureOption(append(lengthUnits, "")...)
+	lengthOrPercentageOrUnitlessOption = measureOption(append(lengthUnits, "%", "")...)
+)
+
+// percentageOption validates a non-negative integer optionally followed by a percent sign.
+func percentageOption(value string) (string, error) {
+	v := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(value), "%"))
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return "", fmt.Errorf("invalid literal for int(): %q", v)
+	}
+	if n < 0 {
+		return "", errors.New("negative value; must be positive or zero")
+	}
+	return v, nil
+}
+
+// choiceOption returns an optionFunc that accepts one of the values in choices. Values are case insensitive.
+func choiceOption(choices ...string) optionFunc {
+	return func(value string) (string, error) {
+		v := strings.ToLower(strings.TrimSpace(value))
+		if contains(choices, v) {
+			return v, nil
+		}
+		var quoted []string
+		for _, c := range choices {
+			quoted = append(quoted, strconv.Quote(c))
+		}
+		last := len(quoted) - 1
+		list := strings.Join(quoted[:last], ", ") + ", or " + quoted[last]
+		return "", fmt.Errorf("%q unknown; choose from %s", v, list)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// imageOptions are the options of the image directive. The figure directive accepts these options as well.
+var imageOptions = map[string]optionFunc{
+	"alt":    unchangedOption,
+	"height": lengthOrUnitlessOption,
+	"width":  lengthOrPercentageOrUnitlessOption,
+	"scale":  percentageOption,
+	"align":  choiceOption("top", "middle", "bottom", "left", "center", "right"),
+	"target": unchangedRequiredOption,
+	"class":  classOption,
+	"name":   unchangedOption,
+}
+
+func init() {
+	registerDirective("image", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec:              imageOptions,
+		run:                     imageDirective,
+	})
+
+	figureOptions := map[string]optionFunc{
+		"figwidth": figwidthOption,
+		"figclass": classOption,
+		"align":    choiceOption("left", "center", "right"),
+	}
+	for name, f := range imageOptions {
+		if _, ok := figureOptions[name]; !ok {
+			figureOptions[name] = f
+		}
+	}
+	registerDirective("figure", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec:              figureOptions,
+		hasContent:              true,
+		run:                     figureDirective,
+	})
+}
+
+// figwidthOption validates the figwidth option, which is either "image" or a length or percentage.
+func figwidthOption(value string) (string, error) {
+	if strings.TrimSpace(value) == "image" {
+		return "image", nil
+	}
+	return lengthOrPercentageOrUnitlessOption(value)
+}
+
+// newImage creates an ImageNode from the directive block. The image URI is resolved using the configured ImageResolver. If
+// the scale option is given, the width and height are scaled. Missing dimensions of scaled images are computed from the
+// image size read using the resolver. A warning is generated if the size cannot be read.
+func (p *Parser) newImage(d *directiveBlock) *doc.ImageNode {
+	uri := strings.Join(strings.Fields(d.arguments[0]), "")
+	img := doc.NewImage(uri, p.directiveItem(d))
+	img.Alt = d.options["alt"]
+	img.Height = d.options["height"]
+	img.Width = d.options["width"]
+	img.Target = d.options["target"]
+	img.Classes = d.classes()
+	img.Names = d.names()
+	if d.hasOption("scale") {
+		img.Scale, _ = strconv.Atoi(d.options["scale"])
+	}
+	if d.name == "image" {
+		img.Align = d.options["align"]
+	}
+
+	r := p.Config.ImageResolver
+	if r != nil {
+		if resolved, err := r.ResolveURI(uri); err == nil {
+			img.URI = resolved
+		} else {
+			p.directiveMessage(mes.DirectiveWarningImageURI, d, uri, err)
+		}
+	}
+	if img.Scale == 0 {
+		return img
+	}
+	img.Width = scaleLength(img.Width, img.Scale)
+	img.Height = scaleLength(img.Height, img.Scale)
+	if r != nil && (img.Width == "" || img.Height == "") {
+		w, h, err := r.ImageSize(uri)
+		if err != nil {
+			p.directiveMessage(mes.DirectiveWarningImageSize, d, uri, err)
+			return img
+		}
+		if img.Width == "" {
+			img.Width = scaleLength(strconv.Itoa(w)+"px", img.Scale)
+		}
+		if img.Height == "" {
+			img.Height = scaleLength(strconv.Itoa(h)+"px", img.Scale)
+		}
+	}
+	return img
+}
+
+// scaleLength multiplies the number of a validated length by scale percent. Empty lengths are returned unchanged.
+func scaleLength(length string, scale int) string {
+	m := measure.FindStringSubmatch(length)
+	if m == nil {
+		return length
+	}
+	n, _ := strconv.ParseFloat(m[1], 64)
+	return strconv.FormatFloat(n*float64(scale)/100, 'f', -1, 64) + m[2]
+}
+
+// imageDirective creates an ImageNode.
+func imageDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	return doc.NodeList{p.newImage(d)}, nil
+}
+
+// figureDirective creates a FigureNode containing an image, an optional caption, and an optional legend. The first
+// paragraph of the content is the caption, the remaining content is the legend. An empty comment can be used in place of
+// the caption to create a figure with only a legend.
+func figureDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	f := doc.NewFigure(p.newImage(d))
+	f.Align = d.options["align"]
+	f.Classes = strings.Fields(d.options["figclass"])
+	f.Width = d.options["figwidth"]
+	if f.Width == "image" {
+		f.Width = ""
+		if r := p.Config.ImageResolver; r != nil {
+			if w, _, err := r.ImageSize(d.arguments[0]); err == nil {
+				f.Width = strconv.Itoa(w) + "px"
+			}
+		}
+	}
+
+	content := p.subParse(d.content, d.contentLine, d.startPosition-1+d.indent)
+	if len(content) == 0 {
+		return doc.NodeList{f}, nil
+	}
+	switch n := content[0].(type) {
+	case *doc.ParagraphNode:
+		f.Caption = &doc.CaptionNode{Type: doc.NodeCaption, NodeList: n.NodeList}
+	case *doc.CommentNode:
+		if n.Text != "" {
+			return nil, errors.New("figure caption must be a paragraph or empty comment")
+		}
+	default:
+		return nil, errors.New("figure caption must be a paragraph or empty comment")
+	}
+	if len(content) > 1 {
+		f.Legend = &doc.LegendNode{Type: doc.NodeLegend, NodeList: content[1:]}
+	}
+	return doc.NodeList{f}, nil
+}
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+	"testing/fstest"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestImageScaleFSImageResolver(t *testing.T) {
+	var b bytes.Buffer
+	if err := png.Encode(&b, image.NewRGBA(image.Rect(0, 0, 200, 100))); err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{"images/box.png": &fstest.MapFile{Data: b.Bytes()}}
+
+	tests := []struct {
+		name   string
+		input  string
+		width  string
+		height string
+		msgs   int
+	}{
+		{"scale", ".. image:: images/box.png\n   :scale: 50%", "100px", "50px", 0},
+		{"scale with width", ".. image:: /images/box.png\n   :scale: 50\n   :width: 3em", "1.5em", "50px", 0},
+		{"missing file", ".. image:: images/missing.png\n   :scale: 50", "", "", 1},
+		{"remote", ".. image:: https://example.com/box.png\n   :scale: 50", "", "", 1},
+		{"no scale", ".. image:: images/box.png", "", "", 0},
+	}
+	for _, tt := range tests {
+		p, err := NewParser(tt.name, tt.input, testutil.LoggerConfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.Config.ImageResolver = FSImageResolver{FS: fsys}
+		p.Parse()
+		var img *doc.ImageNode
+		for _, n := range *p.Nodes {
+			if i, ok := n.(*doc.ImageNode); ok {
+				img = i
+			}
+		}
+		if img == nil {
+			t.Errorf("%s: no image node", tt.name)
+			continue
+		}
+		if img.Width != tt.width || img.Height != tt.height {
+			t.Errorf("%s: got width %q height %q, expect width %q height %q", tt.name, img.Width, img.Height,
+				tt.width, tt.height)
+		}
+		if len(*p.Messages) != tt.msgs {
+			t.Errorf("%s: got %d messages, expect %d", tt.name, len(*p.Messages), tt.msgs)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	registerDirective("include", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec: map[string]optionFunc{
+			"start-line":   intOption,
+			"end-line":     intOption,
+			"start-after":  unchangedRequiredOption,
+			"end-before":   unchangedRequiredOption,
+			"literal":      flagOption,
+			"code":         unchangedOption,
+			"encoding":     encodingOption,
+			"tab-width":    intOption,
+			"number-lines": numberLinesOption,
+			"class":        classOption,
+			"name":         unchangedOption,
+		},
+		run: includeDirective,
+	})
+}
+
+// encodingOption validates the name of a supported text encoding.
+func encodingOption(value string) (string, error) {
+	if _, err := lookupEncoding(value, nil); err != nil {
+		return "", err
+	}
+	return normalizeEncodingName(value), nil
+}
+
+// sliceIndex converts the slice index i of a list of length n to an index in the range 0 to n using the semantics of
+// Python slices: negative indexes count from the end and indexes out of range are clamped.
+func sliceIndex(i, n int) int {
+	if i < 0 {
+		i += n
+	}
+	if i < 0 {
+		return 0
+	} else if i > n {
+		return n
+	}
+	return i
+}
+
+// includePath returns the path of the included file arg in the parser's file system. Paths are relative to the
+// directory of the including file, or to the root of the file system for the main document.
+func (p *Parser) includePath(arg string) (string, error) {
+	name := strings.Join(strings.Fields(arg), "")
+	if strings.HasPrefix(name, "<") && strings.HasSuffix(name, ">") {
+		return "", fmt.Errorf("InputError: standard include files are not supported: %q", name)
+	}
+	dir := "."
+	if n := len(p.includes); n > 0 {
+		dir = path.Dir(p.includes[n-1])
+	}
+	name = path.Join(dir, strings.TrimPrefix(name, "/"))
+	if !fs.ValidPath(name) {
+		return "", fmt.Errorf("InputError: invalid path: %q", arg)
+	}
+	return name, nil
+}
+
+// readFile reads the file name from the configured file system and decodes it using the encoding option of the directive
+// block, or the InputEncoding of the Config if the option is not given. Line endings are converted to newlines.
+func (p *Parser) readFile(d *directiveBlock, name string) (string, error) {
+	b, err := fs.ReadFile(p.Config.FS, name)
+	if err != nil {
+		var pe *fs.PathError
+		if errors.As(err, &pe) {
+			err = pe.Err
+		}
+		return "", newDirectiveError(mes.DirectiveSeverePath, d.name, fmt.Sprintf("InputError: %s: %q", err, name))
+	}
+	encoding, ok := d.options["encoding"]
+	if !ok {
+		encoding = p.Config.InputEncoding
+	}
+	text, err := decode(b, encoding)
+	if err != nil {
+		return "", newDirectiveError(mes.DirectiveSevereEncoding, d.name, fmt.Sprintf("UnicodeDecodeError: %s", err))
+	}
+	return text, nil
+}
+
+// includeDirective reads a file from the configured file system and parses it as if its text was part of the document at
+// the position of the directive. Sections in the included file continue the section structure of the document. With
+// the literal or code options, the text is inserted as a literal block instead. System messages generated while parsing
+// the included text have the path of the file as their source.
+func includeDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if p.Config.FS == nil {
+		return nil, newDirectiveError(mes.DirectiveWarningDirectiveDisabled, d.name)
+	}
+	name, err := p.includePath(d.arguments[0])
+	if err != nil {
+		return nil, newDirectiveError(mes.DirectiveSeverePath, d.name, err.Error())
+	}
+	for _, inc := range p.includes {
+		if inc == name {
+			chain := append(append([]string{}, p.includes...), name)
+			for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+				chain[i], chain[j] = chain[j], chain[i]
+			}
+			return nil, newDirectiveError(mes.DirectiveSevereIncludeRecursion, strings.Join(chain, "\n< "))
+		}
+	}
+
+	text, err := p.readFile(d, name)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	start, end := 0, len(lines)
+	if d.hasOption("start-line") {
+		start, _ = strconv.Atoi(d.options["start-line"])
+		start = sliceIndex(start, len(lines))
+	}
+	if d.hasOption("end-line") {
+		end, _ = strconv.Atoi(d.options["end-line"])
+		end = sliceIndex(end, len(lines))
+	}
+	text = ""
+	if start < end {
+		text = strings.Join(lines[start:end], "")
+	}
+
+	// offset is the number of lines in the file before the included text
+	offset := start
+	if after, ok := d.options["start-after"]; ok {
+		i := strings.Index(text, after)
+		if i < 0 {
+			return nil, newDirectiveError(mes.DirectiveSevereIncludeTextNotFound, "start-after")
+		}
+		offset += strings.Count(text[:i+len(after)], "\n")
+		text = text[i+len(after):]
+	}
+	if before, ok := d.options["end-before"]; ok {
+		i := strings.Index(text, before)
+		if i < 0 {
+			return nil, newDirectiveError(mes.DirectiveSevereIncludeTextNotFound, "end-before")
+		}
+		text = text[:i]
+	}
+
+	tabWidth := p.Config.tabWidth()
+	if d.hasOption("tab-width") {
+		tabWidth, _ = strconv.Atoi(d.options["tab-width"])
+	}
+	if d.hasOption("literal") || d.hasOption("code") {
+		if tabWidth > 0 {
+			text = tok.ExpandTabs(text, tabWidth)
+		}
+		text = strings.TrimSuffix(text, "\n")
+		// The text is not in the input, the literal block is located at the include directive
+		if d.hasOption("code") {
+			lb, err := p.codeLiteralBlock(d, text, d.options["code"], offset+1, 1)
+			if err != nil {
+				return nil, err
+			}
+			lb.Span = doc.NewSpan(p.directiveItem(d))
+			return doc.NodeList{lb}, nil
+		}
+		lb := doc.NewLiteralBlock(&tok.Item{
+			Text:          text,
+			Length:        utf8.RuneCountInString(text),
+			Line:          offset + 1,
+			StartPosition: 1,
+		})
+		lb.Span = doc.NewSpan(p.directiveItem(d))
+		lb.Classes = d.classes()
+		lb.Names = d.names()
+		return doc.NodeList{lb}, nil
+	}
+
+	if tabWidth <= 0 {
+		tabWidth = p.Config.tabWidth()
+	}
+	p.includeParse(name, tok.ExpandTabs(text, tabWidth), offset)
+	return nil, nil
+}
+
+// includeParse parses text read from the file name into the document at the current position. The parser of the included
+// text shares the document tree and section levels with p so sections in the included text are nested correctly.
+func (p *Parser) includeParse(name, text string, lineOffset int) {
+	text = strings.TrimRight(text, "\n")
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	sp, err := NewParserWithConfig(name, text, p.Config, p.logConf)
+	if err != nil {
+		p.Err(err)
+		return
+	}
+	sp.source.lineOffset = lineOffset
+	sp.subParser = true
+	sp.includes = append(append([]string{}, p.includes...), name)
+	sp.roles = p.roles
+	sp.lex.LineOffset = lineOffset
+	// The included text is not in the input of the document, its nodes are not located
+	sp.lex.Index = nil
+	sp.Document = p.Document
+	sp.Nodes = p.Nodes
+	sp.nodeTarget = p.nodeTarget
+	sp.sectionLevels = p.sectionLevels
+	sp.blockQuotes = p.blockQuotes
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	sp.Parse()
+	for _, m := range *sp.Messages {
+		if sm, ok := m.(*doc.SystemMessageNode); ok && sm.Source == "" {
+			sm.Source = name
+		}
+	}
+	p.Messages.Append(*sp.Messages...)
+	p.transforms = append(p.transforms, sp.transforms...)
+}
@@ -0,0 +1,34 @@
+package parser
+
+import (
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestIncludeDisabledWithoutFS(t *testing.T) {
+	p, err := NewParser("test", ".. include:: other.rst", testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Parse()
+	if len(*p.Messages) != 1 {
+		t.Fatalf("got %d messages, expect 1", len(*p.Messages))
+	}
+	sm := (*p.Messages)[0].(*doc.SystemMessageNode)
+	if sm.MessageType != "DirectiveWarningDirectiveDisabled" || sm.Severity != "WARNING" {
+		t.Errorf("got %s %s, expect WARNING DirectiveWarningDirectiveDisabled", sm.Severity, sm.MessageType)
+	}
+}
+
+func TestSliceIndex(t *testing.T) {
+	tests := []struct{ i, n, expect int }{
+		{0, 5, 0}, {2, 5, 2}, {7, 5, 5}, {-1, 5, 4}, {-7, 5, 0},
+	}
+	for _, tt := range tests {
+		if got := sliceIndex(tt.i, tt.n); got != tt.expect {
+			t.Errorf("sliceIndex(%d, %d) = %d, expect %d", tt.i, tt.n, got, tt.expect)
+		}
+	}
+}
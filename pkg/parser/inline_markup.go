@@ -39,23 +39,23 @@ main:
 		switch ci.Type {
 		case tok.InlineEmphasis:
 			ni.Text += "\n" + ci.Text
+			extendItem(ni, ci)
 		case tok.BlankLine:
 			continue
 		default:
 			p.backup()
 			break main
 		}
-		if i.ID == 100 {
-			p.DumpExit(ci)
-		}
 	}
 	if ni.Text == "*" {
 		// p.DumpExit(ni)
 		p.dumpBufferWithContext()
 	}
 	ni.Length = utf8.RuneCountInString(ni.Text)
-	p.nodeTarget.Append(doc.NewInlineEmphasis(ni))
+	n := doc.NewInlineEmphasis(ni)
+	p.nodeTarget.Append(n)
 	p.next(1)
+	p.markupSpan(&n.Span, i, tok.InlineEmphasisClose)
 }
 
 func (p *Parser) inlineStrong(i *tok.Item, titleCheck bool) {
@@ -79,6 +79,7 @@ main:
 		switch ci.Type {
 		case tok.InlineStrong:
 			ni.Text += "\n" + ci.Text
+			extendItem(ni, ci)
 		case tok.BlankLine:
 			continue
 		default:
@@ -87,8 +88,10 @@ main:
 		}
 	}
 	ni.Length = utf8.RuneCountInString(ni.Text)
-	p.nodeTarget.Append(doc.NewInlineStrong(ni))
+	n := doc.NewInlineStrong(ni)
+	p.nodeTarget.Append(n)
 	p.next(1)
+	p.markupSpan(&n.Span, i, tok.InlineStrongClose)
 }
 
 func (p *Parser) inlineLiteral(i *tok.Item, titleCheck bool) {
@@ -112,6 +115,7 @@ main:
 		switch ci.Type {
 		case tok.InlineLiteral:
 			ni.Text += "\n" + ci.Text
+			extendItem(ni, ci)
 		case tok.BlankLine:
 			continue
 		default:
@@ -120,24 +124,76 @@ main:
 		}
 	}
 	ni.Length = utf8.RuneCountInString(ni.Text)
-	p.nodeTarget.Append(doc.NewInlineLiteral(ni))
+	n := doc.NewInlineLiteral(ni)
+	p.nodeTarget.Append(n)
 	p.next(1)
+	p.markupSpan(&n.Span, i, tok.InlineLiteralClose)
 }
 
 func (p *Parser) inlineInterpretedText(i *tok.Item) {
+	p.inlineMarkupParagraph(i)
 	p.next(1)
 	n := doc.NewInlineInterpretedText(p.token)
-	p.nodeTarget.Append(n)
 	p.next(1)
-	if p.peek(1).Type == tok.InlineInterpretedTextRoleOpen {
+	p.markupSpan(&n.Span, i, tok.InlineInterpretedTextClose)
+	if ro := p.peek(1); ro.Type == tok.InlineInterpretedTextRoleOpen {
 		p.next(2)
-		n.NodeList.Append(doc.NewInlineInterpretedTextRole(p.token))
+		r := doc.NewInlineInterpretedTextRole(p.token)
+		n.NodeList.Append(r)
 		p.next(1)
+		p.markupSpan(&r.Span, ro, tok.InlineInterpretedTextRoleClose)
+		n.Span.Extend(r.Span)
 	}
+	p.nodeTarget.Append(p.interpretRole(n)...)
 }
 
+// inlineInterpretedTextRole parses a role that comes before the interpreted text, i.e., ":code:`text`".
 func (p *Parser) inlineInterpretedTextRole(i *tok.Item) {
+	p.inlineMarkupParagraph(i)
 	p.next(1)
-	p.nodeTarget.Append(doc.NewInlineInterpretedTextRole(p.token))
+	r := doc.NewInlineInterpretedTextRole(p.token)
 	p.next(1)
+	p.markupSpan(&r.Span, i, tok.InlineInterpretedTextRoleClose)
+	if pi := p.peek(1); pi == nil || pi.Type != tok.InlineInterpretedTextOpen {
+		p.nodeTarget.Append(r)
+		return
+	}
+	p.next(2)
+	n := doc.NewInlineInterpretedText(p.token)
+	n.NodeList.Append(r)
+	p.next(1)
+	p.markupSpan(&n.Span, nil, tok.InlineInterpretedTextClose)
+	n.Span.Extend(r.Span)
+	p.nodeTarget.Append(p.interpretRole(n)...)
+}
+
+// inlineMarkupParagraph opens a new paragraph if the inline markup starting at i is the first element of a paragraph, i.e.,
+// it is not preceded by other elements of the paragraph. Inline markup in a section title is not part of a paragraph.
+func (p *Parser) inlineMarkupParagraph(i *tok.Item) {
+	if p.isInlineMarkupInSectionTitle(i) {
+		return
+	}
+	if p.nodeTarget.IsParagraphNode() {
+		if pi := p.peekBack(1); pi != nil && pi.Type != tok.BlankLine {
+			return
+		}
+		// The previous paragraph ended at the blank line
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	np := doc.NewParagraph()
+	p.nodeTarget.Append(np)
+	p.nodeTarget.SetParent(np)
+}
+
+// markupSpan extends the span s of an inline markup node to include the open marker i and the close marker of type close
+// at the current token. Nil markers and a current token of another type are ignored.
+func (p *Parser) markupSpan(s *doc.Span, i *tok.Item, close tok.Type) {
+	s.Extend(doc.NewSpan(i))
+	if p.token != nil && p.token.Type == close {
+		s.Extend(doc.NewSpan(p.token))
+	}
 }
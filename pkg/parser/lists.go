@@ -1,137 +1,343 @@
 package parser
 
 import (
-	"errors"
-	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
 	tok "github.com/demizer/go-rst/pkg/token"
 )
 
-func (p *Parser) definitionTerm(i *tok.Item) doc.Node {
-	//
-	//  FIXME: Definition list parsing is NOT fully implemented.
-	//
-	dl := doc.NewDefinitionList(&tok.Item{Line: i.Line})
-	p.nodeTarget.Append(dl)
-	p.nodeTarget.SetParent(dl)
-	p.next(1)
+// classifierDelimiter separates the classifiers of a definition list term from the term and from each other.
+var classifierDelimiter = regexp.MustCompile(` +: +`)
+
+// definitionList parses a definition list beginning at the term token i. The items of the list are parsed from the input
+// text. An item is a term line followed by the indented lines of the definition, items may be separated by blank lines. The
+// list ends at the first line that is not a term followed by an indented line.
+func (p *Parser) definitionList(i *tok.Item) {
+	lines := strings.Split(p.text, "\n")
+	line := i.Line - p.lex.LineOffset
+	col := i.StartPosition - 1 - p.lex.PositionOffset
 
-	// Container for definition items
-	dli := doc.NewDefinitionListItem(i, p.peek(1))
-	p.nodeTarget.Append(dli)
-	p.nodeTarget.SetParent(dli.Definition)
+	dl := doc.NewDefinitionList(i)
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	p.nodeTarget.Append(dl)
 
-	// Gather definitions and body elements
+	var end int
+	var blankFinish bool
 	for {
-		ni := p.next(1)
-		if ni == nil {
-			break
+		var dli *doc.DefinitionListItemNode
+		dli, end, blankFinish = p.definitionListItem(lines, line, col)
+		dl.Append(dli)
+		p.skipLines(end)
+
+		next := end + 1
+		for next <= len(lines) && strings.TrimSpace(lines[next-1]) == "" {
+			next++
 		}
-		p.Msgr("Have token", "token", ni)
-		pb := p.peekBack(1)
-		if ni.Type == tok.Space {
-			p.Msg("continue; ni.Type == Space")
-			continue
-		} else if ni.Type == tok.EOF {
-			p.Msg("break; ni.Type == EOF")
-			break
-		} else if ni.Type == tok.BlankLine {
-			p.Msg("Setting nodeTarget to dli")
-			p.nodeTarget.SetParent(dli.Definition)
-		} else if ni.Type == tok.CommentMark && (pb != nil && pb.Type != tok.Space) {
-			// Comment at start of the line breaks current definition list
-			p.Msg("Have tok.CommentMark at start of the line!")
-			p.nodeTarget.Reset()
-			p.backup()
+		if next >= len(lines) || indentation(lines[next-1]) != col || indentation(lines[next]) <= col ||
+			strings.TrimSpace(lines[next]) == "" {
 			break
-		} else if ni.Type == tok.DefinitionText {
-			// FIXME: This function is COMPLETELY not final. It is only setup for passing section test TitleNumberedGood0100.
-			np := doc.NewParagraphWithNodeText(ni)
-			p.nodeTarget.Append(np)
-			p.nodeTarget.SetParent(np)
-			p.Msg("continue; ni.Type == tok.DefinitionText")
-			continue
-		} else if ni.Type == tok.DefinitionTerm {
-			dli2 := doc.NewDefinitionListItem(ni, p.peek(2))
-			p.nodeTarget.SetParent(dl)
-			p.nodeTarget.Append(dli2)
-			p.nodeTarget.SetParent(dli2.Definition)
-			p.Msg("continue; ni.Type == tok.DefinitionTerm")
+		}
+		line = next
+	}
+	if !blankFinish {
+		p.listMessage(mes.ListWarningDefinitionListUnexpectedUnindent, end+1+p.lex.LineOffset,
+			indentation(lines[end])+1+p.lex.PositionOffset)
+	}
+}
+
+// definitionListItem parses the definition list item with the term at line and column col of lines. The item, the last line
+// of the definition, and true if the definition is followed by a blank line or the end of the input are returned.
+func (p *Parser) definitionListItem(lines []string, line, col int) (dli *doc.DefinitionListItemNode, end int,
+	blankFinish bool) {
+	text := strings.TrimRight(lines[line-1][col:], " ")
+	absLine, startPosition := line+p.lex.LineOffset, col+1+p.lex.PositionOffset
+	if strings.HasSuffix(text, "::") {
+		p.listMessage(mes.ListInfoDefinitionListMissingLiteralBlankLine, absLine+1, 1+p.lex.PositionOffset)
+	}
+	dli = doc.NewDefinitionListItem(p.lex.Locate(&tok.Item{Text: text, Length: utf8.RuneCountInString(text),
+		Line: absLine, StartPosition: startPosition}))
+	dli.Term.NodeList, dli.Classifiers = classifiers(p.parseInline(text, absLine, startPosition))
+
+	block, indent, end := indentedBlock(lines, line, col)
+	blankFinish = end == len(lines) || strings.TrimSpace(lines[end]) == ""
+	dli.Definition.NodeList = p.subParse(block, line+1+p.lex.LineOffset, indent+p.lex.PositionOffset)
+	return
+}
+
+// classifiers splits the inline nodes of a definition list term at the classifier delimiters found in the text nodes. The
+// nodes of the term and the classifiers are returned. Delimiters inside inline markup do not separate classifiers.
+func classifiers(nodes doc.NodeList) (term doc.NodeList, cls []*doc.ClassifierNode) {
+	target := &term
+	appendText := func(t *doc.TextNode, start, end int) {
+		if start == end {
+			return
+		}
+		text := t.Text[start:end]
+		*target = append(*target, &doc.TextNode{Type: doc.NodeText, Text: text, Length: utf8.RuneCountInString(text),
+			Line: t.Line, StartPosition: t.StartPosition + utf8.RuneCountInString(t.Text[:start])})
+	}
+	for _, n := range nodes {
+		t, ok := n.(*doc.TextNode)
+		if !ok {
+			*target = append(*target, n)
 			continue
 		}
-		p.subParseBodyElements(ni)
+		start := 0
+		for _, loc := range classifierDelimiter.FindAllStringIndex(t.Text, -1) {
+			appendText(t, start, loc[0])
+			c := doc.NewClassifier()
+			cls = append(cls, c)
+			target = &c.NodeList
+			start = loc[1]
+		}
+		appendText(t, start, len(t.Text))
 	}
-	return dl
+	return
 }
 
-func (p *Parser) bulletList(i *tok.Item) error {
-	//
-	// FIXME: Bullet List Parsing is NOT fully implemented
-	//
+// bulletList parses a bullet list beginning at the bullet token i. The items of the list are parsed from the input text,
+// the body of an item is aligned to the text following the bullet. The list ends at the first line that is not an item
+// with the same bullet, a different bullet begins a new list.
+func (p *Parser) bulletList(i *tok.Item) {
+	lines := strings.Split(p.text, "\n")
+	line := i.Line - p.lex.LineOffset
+	col := i.StartPosition - 1 - p.lex.PositionOffset
+	width := bulletWidth(lines[line-1][col:])
+	if width == 0 {
+		p.Msgr("Invalid bullet", "line", i.Line)
+		return
+	}
+
 	bl := doc.NewBulletListNode(i)
-	p.openList = bl
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
 	p.nodeTarget.Append(bl)
-	p.nodeTarget.SetParent(bl)
 
-	// Get the bullet list paragraph
-	p.next(1)
-	bli := doc.NewBulletListItemNode(i)
+	var end int
+	var blankFinish bool
+	for {
+		var nodes doc.NodeList
+		nodes, end, blankFinish = p.listItem(lines, line, col, width)
+		item := doc.NewBulletListItemNode(p.listMarker(lines, line, col, width))
+		item.NodeList = nodes
+		bl.Append(item)
+		p.skipLines(end)
+
+		next := end + 1
+		for next <= len(lines) && strings.TrimSpace(lines[next-1]) == "" {
+			next++
+		}
+		if next > len(lines) || indentation(lines[next-1]) != col || !strings.HasPrefix(lines[next-1][col:], i.Text) {
+			break
+		}
+		if width = bulletWidth(lines[next-1][col:]); width == 0 {
+			break
+		}
+		line = next
+	}
+	if !blankFinish {
+		p.listMessage(mes.ListWarningBulletListUnexpectedUnindent, end+1+p.lex.LineOffset,
+			indentation(lines[end])+1+p.lex.PositionOffset)
+	}
+}
+
+// bulletWidth returns the width in bytes of the bullet at the start of s and the spaces following it, or zero if s does
+// not begin with a bullet followed by a space or the end of the line.
+func bulletWidth(s string) int { return len(bulletMarker.FindString(s)) }
+
+// isEnumListToken returns true if t is an item emitted by the lexer for the enumerator of an enumerated list item.
+func isEnumListToken(t *tok.Item) bool {
+	switch t.Type {
+	case tok.EnumListAffix, tok.EnumListArabic, tok.EnumListAlpha, tok.EnumListRoman, tok.EnumListAuto:
+		return true
+	}
+	return false
+}
+
+// enumList parses an enumerated list beginning at the enumerator token i, or at the affix token preceding it. The items of
+// the list are parsed from the input text. The list ends at the first line that is not an item with the same format and
+// the next enumerator of the sequence. A following list item with a different format or sequence begins a new list. This
+// is a port of the enumerated list parsing of docutils.
+func (p *Parser) enumList(i *tok.Item) {
+	lines := strings.Split(p.text, "\n")
+	line := i.Line - p.lex.LineOffset
+	col := i.StartPosition - 1 - p.lex.PositionOffset
+	e := tok.ParseEnumerator(lines[line-1][col:], "")
+	if e == nil {
+		p.Msgr("Invalid enumerator", "line", i.Line)
+		return
+	}
 
-	// Set the node target to the bullet list paragraph
-	p.nodeTarget.Append(bli)
-	p.nodeTarget.SetParent(bli)
-	p.indents.add(i, bli)
+	el := doc.NewEnumListNode(e)
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	p.nodeTarget.Append(el)
+	if e.Ordinal != 1 {
+		p.listMessage(mes.ListInfoEnumListStartNotOrdinal, i.Line, i.StartPosition, e.Text, e.Ordinal)
+	}
 
-	// Capture all bullet items until un-indent
+	// Auto enumerated lists are arabic lists, they can only be continued by more auto enumerators
+	auto := e.Sequence == "#"
+	sequence := e.Sequence
+	if auto {
+		sequence = "arabic"
+	}
+	var end int
+	var blankFinish bool
 	for {
-		ni := p.next(1)
-		topNodeList, err := p.indents.topNodeList()
-		if err != nil {
-			p.Err(err)
-		}
-		p.Msgr("Have token", "token", fmt.Sprintf("%+#v", ni))
-		if ni == nil {
-			p.Log("break next item == nil")
+		var nodes doc.NodeList
+		nodes, end, blankFinish = p.listItem(lines, line, col, e.Width)
+		item := doc.NewEnumListItemNode()
+		item.NodeList = nodes
+		item.Span = doc.NewSpan(p.listMarker(lines, line, col, e.Width))
+		el.Append(item)
+		p.skipLines(end)
+
+		next := end + 1
+		for next <= len(lines) && strings.TrimSpace(lines[next-1]) == "" {
+			next++
+		}
+		if next > len(lines) || indentation(lines[next-1]) != col {
+			break
+		}
+		ne := tok.ParseEnumerator(lines[next-1][col:], sequence)
+		if ne == nil || ne.Prefix != e.Prefix || ne.Suffix != e.Suffix {
+			break
+		}
+		if ne.Sequence != "#" && (ne.Sequence != sequence || auto || ne.Ordinal != e.Ordinal+1) {
 			break
-		} else if ni.Type == tok.EOF {
-			p.Log("break itemEOF")
+		}
+		if !ne.IsListItem(relativeLine(lines, next+1, col)) {
 			break
-		} else if p.indents.len() > 0 && len(*topNodeList) > 0 && p.peekBack(1).Type == tok.Space &&
-			p.peekBack(2).Type != tok.CommentMark {
-			lastStartPos, err := p.indents.lastStartPosition()
-			if err != nil {
-				p.Err(err)
+		}
+		if ne.Sequence == "#" {
+			auto = true
+		}
+		e, line = ne, next
+	}
+	if !blankFinish {
+		p.listMessage(mes.ListWarningEnumListUnexpectedUnindent, end+1+p.lex.LineOffset,
+			indentation(lines[end])+1+p.lex.PositionOffset)
+	}
+}
+
+// listMarker returns the located item of the bullet or enumerator of width columns at line and column col of lines. The
+// white space following the marker is not included.
+func (p *Parser) listMarker(lines []string, line, col, width int) *tok.Item {
+	text := strings.TrimRight(lines[line-1][col:col+width], " ")
+	return p.lex.Locate(&tok.Item{Text: text, Length: utf8.RuneCountInString(text), Line: line + p.lex.LineOffset,
+		StartPosition: col + 1 + p.lex.PositionOffset})
+}
+
+// listItem parses the list item with the marker of width columns at line and column col of lines. Lines are the lines of
+// the input, line numbers begin at 1. If text follows the marker, the item contains the lines indented at least to the
+// text. Otherwise the item contains the following lines indented more than the marker, the least indented of these lines
+// determines the indentation of the item. The parsed nodes of the item, the last line of the item, and true if the item is
+// followed by a blank line or the end of the input are returned.
+func (p *Parser) listItem(lines []string, line, col, width int) (nodes doc.NodeList, end int, blankFinish bool) {
+	var block []string
+	first := lines[line-1][col+width:]
+	// Positions are byte offsets, but the body of the item is aligned by columns. These only differ for Unicode bullets.
+	offset := col + width
+	indent, start := col+utf8.RuneCountInString(lines[line-1][col:col+width]), line
+	end = line
+	if strings.TrimSpace(first) != "" {
+		for n := line + 1; n <= len(lines); n++ {
+			if strings.TrimSpace(lines[n-1]) == "" {
+				continue
 			}
-			p.Msgr("Have indents", "lastStartPosition", lastStartPos, "ni.StartPosition", ni.StartPosition)
-			if lastStartPos != ni.StartPosition {
-				return errors.New("Unexpected un-indent!")
+			if indentation(lines[n-1]) < indent {
+				break
 			}
+			end = n
 		}
-
-		p.subParseBodyElements(ni)
+		block = append(block, first)
+		for n := line + 1; n <= end; n++ {
+			block = append(block, dedent(lines[n-1], indent))
+		}
+	} else {
+		start = line + 1
+		block, indent, end = indentedBlock(lines, line, col)
+		offset = indent
+	}
+	blankFinish = end == len(lines) || strings.TrimSpace(lines[end]) == ""
+	if len(block) > 0 {
+		nodes = p.subParse(block, start+p.lex.LineOffset, offset+p.lex.PositionOffset)
 	}
-	p.indents.pop()
-	return nil
+	return
 }
 
-var lastEnum *doc.EnumListNode
+// indentedBlock returns the lines following line that are indented more than col. The lines are dedented by the least
+// indentation of the block. The indentation and the last line of the block are returned. Blank lines at the end of the
+// block are not included.
+func indentedBlock(lines []string, line, col int) (block []string, indent, end int) {
+	indent, end = -1, line
+	for n := line + 1; n <= len(lines); n++ {
+		if strings.TrimSpace(lines[n-1]) == "" {
+			continue
+		}
+		in := indentation(lines[n-1])
+		if in <= col {
+			break
+		}
+		if indent < 0 || in < indent {
+			indent = in
+		}
+		end = n
+	}
+	for n := line + 1; n <= end; n++ {
+		block = append(block, dedent(lines[n-1], indent))
+	}
+	return
+}
 
-func (p *Parser) enumList(i *tok.Item) (n doc.Node) {
-	var eNode *doc.EnumListNode
-	var affix *tok.Item
-	if lastEnum == nil {
+// skipLines advances the token buffer past the tokens on lines up to and including line end. end is a line of the input of
+// the parser, not including the line offset.
+func (p *Parser) skipLines(end int) {
+	for t := p.peek(1); t != nil && t.Type != tok.EOF && t.Line-p.lex.LineOffset <= end; t = p.peek(1) {
 		p.next(1)
-		affix = p.token
-		p.next(1)
-		eNode = doc.NewEnumListNode(i, affix)
-		p.next(1)
-		eNode.NodeList.Append(doc.NewParagraphWithNodeText(p.token))
-	} else {
-		p.next(3)
-		lastEnum.NodeList.Append(doc.NewParagraphWithNodeText(p.token))
-		return nil
 	}
-	lastEnum = eNode
-	return eNode
+}
+
+// indentation returns the number of spaces at the start of s.
+func indentation(s string) int { return len(s) - len(strings.TrimLeft(s, " ")) }
+
+// dedent removes indent columns of white space from the start of s.
+func dedent(s string, indent int) string {
+	if len(s) < indent {
+		return strings.TrimLeft(s, " ")
+	}
+	return s[indent:]
+}
+
+// relativeLine returns line n of lines without the first col columns, or an empty string if there is no line n or the line
+// is indented less than col.
+func relativeLine(lines []string, n, col int) string {
+	if n > len(lines) {
+		return ""
+	}
+	if s := lines[n-1]; len(s) >= col && strings.TrimSpace(s[:col]) == "" {
+		return s[col:]
+	}
+	return ""
 }
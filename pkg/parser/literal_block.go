@@ -0,0 +1,119 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// literalBlockMarker matches a paragraph line ending with "::" that is not escaped by a backslash.
+var literalBlockMarker = regexp.MustCompile(`(^|[^\\])(\\\\)*:: *$`)
+
+// isQuoteChar returns true if r can begin a quoted literal block, any 7-bit ASCII character that is not a letter, digit
+// or white space.
+func isQuoteChar(r byte) bool {
+	return r >= '!' && r <= '/' || r >= ':' && r <= '@' || r >= '[' && r <= '`' || r >= '{' && r <= '~'
+}
+
+// literalBlock parses the literal block following the paragraph para ending at line, if the paragraph ends with "::".
+// The "::" is removed from the paragraph following the rules of the specification: a paragraph containing only "::" is
+// removed, " ::" is removed entirely, and "::" at the end of text becomes ":". The literal block is either indented, or
+// quoted with the same non-alphanumeric character at the start of each line.
+func (p *Parser) literalBlock(para *doc.ParagraphNode, line int) {
+	lines := strings.Split(p.text, "\n")
+	line -= p.lex.LineOffset
+	if line < 1 || line > len(lines) || !literalBlockMarker.MatchString(lines[line-1]) {
+		return
+	}
+	p.Msg("Found literal block marker")
+	p.literalBlockParagraph(para)
+
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+
+	start := line
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+	if start == len(lines) || (indentation(lines[start]) == 0 && !isQuoteChar(lines[start][0])) {
+		p.literalBlockMessage(mes.LiteralBlockWarningNoneFound, start+1+p.lex.LineOffset, 1+p.lex.PositionOffset)
+		return
+	}
+
+	if indentation(lines[start]) > 0 {
+		block, indent, end := indentedBlock(lines, start, 0)
+		p.skipLines(end)
+		p.nodeTarget.Append(p.newLiteralBlock(block, start+1, indent))
+		if end < len(lines) && strings.TrimSpace(lines[end]) != "" {
+			p.literalBlockMessage(mes.LiteralBlockWarningUnexpectedUnindent, end+1+p.lex.LineOffset,
+				1+p.lex.PositionOffset)
+		}
+		return
+	}
+
+	quote := lines[start][0]
+	end := start
+	for end < len(lines) && lines[end] != "" && lines[end][0] == quote {
+		end++
+	}
+	p.skipLines(end)
+	p.nodeTarget.Append(p.newLiteralBlock(lines[start:end], start+1, 0))
+	if end < len(lines) && strings.TrimSpace(lines[end]) != "" {
+		err := mes.LiteralBlockErrorInconsistentQuoting
+		if indentation(lines[end]) > 0 {
+			err = mes.BlockQuoteErrorUnexpectedIndentation
+		}
+		p.literalBlockMessage(err, end+1+p.lex.LineOffset, 1+p.lex.PositionOffset)
+	}
+}
+
+// literalBlockParagraph removes the literal block marker from the end of the paragraph para.
+func (p *Parser) literalBlockParagraph(para *doc.ParagraphNode) {
+	if len(para.NodeList) == 0 {
+		return
+	}
+	tn, ok := para.NodeList.LastNode().(*doc.TextNode)
+	if !ok {
+		return
+	}
+	text := strings.TrimRight(tn.Text, " ")
+	if strings.TrimSpace(text) == "::" && len(para.NodeList) == 1 {
+		// Parse Test 05.00.00.07 :: A paragraph containing only "::" is removed
+		p.Nodes.Remove(para)
+		return
+	}
+	text = strings.TrimSuffix(text, ":")
+	if strings.HasSuffix(text, " :") || strings.HasSuffix(text, "\n:") || text == ":" {
+		text = strings.TrimRight(strings.TrimSuffix(text, ":"), " \n")
+	}
+	tn.Text = text
+	tn.Length = utf8.RuneCountInString(text)
+	if text == "" {
+		para.NodeList.Remove(tn)
+	}
+}
+
+// newLiteralBlock returns a LiteralBlockNode containing the lines of block. line is the line of the first line of the
+// block and indent is the number of columns the block was indented. If the KeepLiteralTabs setting is enabled, the text is
+// taken from the input before whitespace normalization.
+func (p *Parser) newLiteralBlock(block []string, line, indent int) *doc.LiteralBlockNode {
+	text := strings.Join(block, "\n")
+	if p.Config.KeepLiteralTabs {
+		text = p.literalText(text, line+p.lex.LineOffset, len(block), indent+p.lex.PositionOffset, 0)
+	}
+	return doc.NewLiteralBlock(p.lex.Locate(&tok.Item{
+		Text:          text,
+		Length:        utf8.RuneCountInString(text),
+		Line:          line + p.lex.LineOffset,
+		StartPosition: indent + 1 + p.lex.PositionOffset,
+	}))
+}
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	registerDirective("math", &directive{
+		optionSpec: map[string]optionFunc{
+			"class": classOption,
+			"name":  unchangedOption,
+		},
+		hasContent: true,
+		run:        mathDirective,
+	})
+	registerRole("math", mathRole)
+}
+
+// mathDirective creates a MathBlockNode for each block of LaTeX math in the content. Blocks are separated by blank lines.
+// The name option applies to the first block.
+func mathDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+	var nodes doc.NodeList
+	var block []string
+	line := d.contentLine
+	for i := 0; i <= len(d.content); i++ {
+		if i < len(d.content) && strings.TrimSpace(d.content[i]) != "" {
+			if len(block) == 0 {
+				line = d.contentLine + i
+			}
+			block = append(block, d.content[i])
+			continue
+		}
+		if len(block) == 0 {
+			continue
+		}
+		text := strings.Join(block, "\n")
+		m := doc.NewMathBlock(p.lex.Locate(&tok.Item{
+			Text:          text,
+			Length:        utf8.RuneCountInString(text),
+			Line:          line,
+			StartPosition: d.startPosition,
+		}))
+		m.Classes = d.classes()
+		if len(nodes) == 0 {
+			m.Names = d.names()
+		}
+		nodes = append(nodes, m)
+		block = nil
+	}
+	return nodes, nil
+}
+
+// mathRole creates a MathNode containing the LaTeX source of the interpreted text.
+func mathRole(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+	return doc.NodeList{doc.NewMath(roleItem(n))}
+}
@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	registerDirective("meta", &directive{
+		hasContent: true,
+		run:        metaDirective,
+	})
+	registerDirective("title", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		run:                     titleDirective,
+	})
+}
+
+// metaField is a field of the field list in the content of a meta directive.
+type metaField struct {
+	name string
+	body []string
+	line int
+}
+
+// metaDirective creates a MetaNode for each field of the field list in the content. The field name is the name of the
+// metadata, optionally followed by attributes, i.e., ":description lang=en:". If the first word of the field name contains
+// "=", it is an attribute instead of the name, i.e., ":http-equiv=Content-Type:". The field body is the content.
+func metaDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+	var fields []*metaField
+	for i, l := range d.content {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		if m := fieldMarker.FindStringSubmatch(l); m != nil {
+			fields = append(fields, &metaField{
+				name: strings.TrimSpace(m[1]),
+				body: []string{l[len(m[0]):]},
+				line: d.contentLine + i,
+			})
+			continue
+		}
+		if len(fields) == 0 || !strings.HasPrefix(l, " ") {
+			return nil, newDirectiveError(mes.DirectiveErrorMetaInvalid)
+		}
+		f := fields[len(fields)-1]
+		f.body = append(f.body, l)
+	}
+
+	var nodes doc.NodeList
+	for _, f := range fields {
+		content := strings.Join(strings.Fields(strings.Join(f.body, " ")), " ")
+		if content == "" {
+			p.directiveMessage(mes.DirectiveErrorMetaNoContent, d, f.name)
+			continue
+		}
+		m := doc.NewMeta(content, f.line)
+		if err := setMetaAttributes(m, f.name); err != nil {
+			de := err.(*directiveError)
+			p.directiveMessage(de.typ, d, de.args...)
+			continue
+		}
+		nodes = append(nodes, m)
+	}
+	return nodes, nil
+}
+
+// setMetaAttributes sets the name and attributes of m from the words of the field name. A directiveError is returned if an
+// attribute is not of the form "name=value", or if the attribute name contains characters other than letters, digits and
+// "-".
+func setMetaAttributes(m *doc.MetaNode, fieldName string) error {
+	words := strings.Fields(fieldName)
+	for i, w := range words {
+		if i == 0 && !strings.Contains(w, "=") {
+			m.Name = w
+			continue
+		}
+		eq := strings.Index(w, "=")
+		if eq < 0 {
+			return newDirectiveError(mes.DirectiveErrorMetaAttribute, w, "missing \"=\"")
+		} else if eq == 0 {
+			return newDirectiveError(mes.DirectiveErrorMetaAttribute, w, "missing attribute name before \"=\"")
+		}
+		name, value := strings.ToLower(w[:eq]), strings.Trim(w[eq+1:], `"'`)
+		if strings.IndexFunc(name, isNotAttributeNameRune) != -1 {
+			return newDirectiveError(mes.DirectiveErrorMetaAttribute, w, "invalid attribute name")
+		}
+		switch name {
+		case "name":
+			m.Name = value
+		case "http-equiv":
+			m.HTTPEquiv = value
+		default:
+			if m.Attributes == nil {
+				m.Attributes = make(map[string]string)
+			}
+			m.Attributes[name] = value
+		}
+	}
+	return nil
+}
+
+// isNotAttributeNameRune returns true if r is not a letter, digit or "-". Meta attribute names are written to the document
+// head as HTML attribute names.
+func isNotAttributeNameRune(r rune) bool {
+	return r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// titleDirective sets the title of the document metadata to the argument. The title is not added to the document body.
+func titleDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	return doc.NodeList{doc.NewDocumentTitle(p.lex.Locate(&tok.Item{
+		Text:          d.arguments[0],
+		Line:          d.line,
+		StartPosition: d.argPosition,
+	}))}, nil
+}
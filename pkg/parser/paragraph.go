@@ -4,6 +4,7 @@ import (
 	"unicode/utf8"
 
 	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
 	tok "github.com/demizer/go-rst/pkg/token"
 )
 
@@ -17,26 +18,25 @@ func (p *Parser) paragraph(i *tok.Item) doc.Node {
 	// }
 
 	tmp := p.peekLine(p.token.Line - 1)
-	newParagraph := tmp != nil && tmp.Type == tok.BlankLine
-	if newParagraph && p.nodeTarget.IsParagraphNode() { //&& p.bqLevel == nil {
+	// Text following inline markup on the same line continues the paragraph started by the markup
+	pi := p.peekBack(1)
+	newParagraph := tmp != nil && tmp.Type == tok.BlankLine && (pi == nil || pi.Line != i.Line)
+	if newParagraph && p.nodeTarget.IsParagraphNode() {
 		// p.nodeTarget.Reset()
 		// p.nodeTarget.SetParent(
 		p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
 		// p.DumpExit(i)
 		// p.DumpExit(p.token)
 	}
-	// if newParagraph && !p.nodeTarget.IsParagraphNode() { //&& p.bqLevel == nil {
-	if !p.nodeTarget.IsParagraphNode() { //&& p.bqLevel == nil {
-		// if !p.nodeTarget.IsParagraphNode() { //&& p.bqLevel == nil {
+	if !p.nodeTarget.IsParagraphNode() {
 		np := doc.NewParagraph()
 		p.nodeTarget.Append(np)
 		p.nodeTarget.SetParent(np)
 	}
 	nt := doc.NewText(i)
 	p.nodeTarget.Append(nt)
-	// if i.Type == tok.Text && i.Line == 7 {
-	// p.DumpExit(p.bqLevel)
-	// }
+	para, _ := p.nodeTarget.Parent.(*doc.ParagraphNode)
+	last := 0 // The last line of the paragraph
 outer:
 	// Paragraphs can contain many different types of elements, so we'll need to loop until blank line or nil
 	for {
@@ -61,16 +61,21 @@ outer:
 		// if ci == nil || ci.Type == tok.EOF || ci.Type == tok.Title {
 		if ci == nil || ci.Type == tok.EOF {
 			p.Msg("current token == nil or current item type == tok.EOF")
+			if ci != nil {
+				last = ci.Line
+			}
 			break
 		} else if pi != nil && pi.Type == tok.Text && ci.Type == tok.Text {
 			p.Msg("Found two sequential tok.Text! Concatenating text!")
 			nt.Text += "\n" + ci.Text
 			nt.Length = utf8.RuneCountInString(nt.Text)
+			nt.Span.Extend(doc.NewSpan(ci))
 			continue
 		} else if pi != nil && pi.Type == tok.Text && ci.Type == tok.Escape && ni != nil && ni.Type == tok.Text {
 			p.Msg("Found escaped newline! Concatenating text!")
 			nt.Text += ni.Text
 			nt.Length = utf8.RuneCountInString(nt.Text)
+			nt.Span.Extend(doc.NewSpan(ni))
 			p.next(1)
 			continue
 		}
@@ -87,16 +92,29 @@ outer:
 				// Parse test 04.01.03.00 :: Indented section title
 				// Need to make sure the space is not before a title
 				continue
+			} else if pi != nil && pi.Line < ci.Line && p.isIndented(ci) {
+				// Parse Test 03.00.00.04 :: An indented line ends the paragraph and begins a block quote
+				p.backup()
+				if p.sectionLevels.lastSectionNode != nil {
+					p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+				} else {
+					p.nodeTarget.Reset()
+				}
+				p.blockQuoteMessage(mes.BlockQuoteErrorUnexpectedIndentation, ci.Line, 1+p.lex.PositionOffset)
+				last = ci.Line - 1
+				break outer
 			}
 			// Parse Test 02.00.03.00 :: Emphasis wrapped in unicode spaces
 			nt.Text += "\n" + ci.Text
 			nt.Length = utf8.RuneCountInString(nt.Text)
+			nt.Span.Extend(doc.NewSpan(ci))
 		case tok.Text:
 			if pi != nil && pi.Type == tok.Escape && pi.StartPosition > ci.StartPosition {
 				// Parse Test 02.00.01.00 :: Catch escapes at the end of lines
 				p.Msg("Found newline escape!")
 				nt.Text += ci.Text
 				nt.Length = utf8.RuneCountInString(nt.Text)
+				nt.Span.Extend(doc.NewSpan(ci))
 			} else {
 				nt = doc.NewText(ci)
 				p.nodeTarget.Append(nt)
@@ -113,11 +131,27 @@ outer:
 			p.inlineInterpretedTextRole(ci)
 		case tok.CommentMark:
 			p.comment(ci)
-		case tok.EnumListArabic:
-			p.nodeTarget.Append(p.enumList(ci))
+		case tok.Bullet, tok.EnumListAffix, tok.EnumListArabic, tok.EnumListAlpha, tok.EnumListRoman, tok.EnumListAuto:
+			// Bullets and enumerators are text in the lines following the first line of a paragraph
+			text := ci.Text
+			for ni := p.peek(1); ni != nil && ni.Line == ci.Line && (isEnumListToken(ni) || ni.Type == tok.Space ||
+				ni.Type == tok.Text); ni = p.peek(1) {
+				text += p.next(1).Text
+			}
+			ti := p.lex.Locate(&tok.Item{Text: text, Length: utf8.RuneCountInString(text), Line: ci.Line,
+				StartPosition: ci.StartPosition})
+			if pi != nil && pi.Type == tok.Text {
+				nt.Text += "\n" + text
+				nt.Length = utf8.RuneCountInString(nt.Text)
+				nt.Span.Extend(doc.NewSpan(ti))
+			} else {
+				nt = doc.NewText(ti)
+				p.nodeTarget.Append(nt)
+			}
 		case tok.BlankLine:
 			p.Msg("Found newline, closing paragraph")
 			p.backup()
+			last = ci.Line - 1
 			break outer
 		default:
 			p.printToken("token not supported in paragraphs", ci)
@@ -127,11 +161,10 @@ outer:
 		// p.DumpExit(p.buf)
 		// panic("halt")
 	}
-	p.Msgr("number of indents", "p.indents.len", p.indents.len())
-	if p.indents.len() > 0 {
-		p.nodeTarget.SetParent(p.indents.topNode())
-		p.Msgr("Set node target to p.indents.topNodeList!", "nodePtr", p.nodeTarget)
-	} else if len(p.sectionLevels.levels) == 0 {
+	if para != nil && last > 0 && !p.inline {
+		p.literalBlock(para, last)
+	}
+	if len(p.sectionLevels.levels) == 0 {
 		p.Msg("Setting node target to p.nodes!")
 		p.nodeTarget.Reset()
 	}
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 
 	"golang.org/x/text/unicode/norm"
 
@@ -18,16 +19,18 @@ const (
 
 // Parser contains the parser Parser. The Nodes field contains the parsed nodes of the input input data.
 type Parser struct {
-	Name     string        // The name of the current parser input
-	Nodes    *doc.NodeList // The root node list
-	Messages *doc.NodeList // Messages generated by the parser
+	Name     string            // The name of the current parser input
+	Document *doc.DocumentNode // The root node of the document
+	Nodes    *doc.NodeList     // The root node list, the NodeList of Document
+	Messages *doc.NodeList     // Messages generated by the parser
+	Config   Config            // Settings used while parsing, set before calling Parse
 
 	nodeTarget *doc.NodeTarget // Used to append nodes to a target NodeList
-	text       string          // The input text
+	text       string          // The normalized input text, lines and positions of tokens refer to this text
+	source     *sourceText     // The input text before whitespace normalization
 	lex        *tok.Lexer      // The place where tokens come from
-	indents    *indentQueue    // Indent level tracking
 
-	bqLevel *doc.BlockQuoteNode // FIXME: will be replaced with blockquoteLevels
+	blockQuotes *blockQuoteLevels // Block quotes being parsed
 
 	sectionLevels   *sectionLevels        // Encountered section levels
 	sections        []*doc.SectionNode    // Pointers to encountered sections
@@ -35,6 +38,15 @@ type Parser struct {
 
 	openList doc.Node // Open Bullet List, Enum List, or Definition List
 
+	transforms []transform         // Transforms applied when parsing is complete
+	ids        map[string]bool     // Element ids used in the document
+	idCounters map[string]int      // Counters for generated ids by prefix
+	subParser  bool                // True if parsing nested content of another parser
+	inline     bool                // True if parsing text that can only contain inline markup
+	nested     bool                // True if parsing the content of a body element, transitions are not allowed
+	includes   []string            // Paths of the files being included, the last is the file being parsed
+	roles      map[string]roleFunc // Roles defined by role directives in the document
+
 	tokenBuffer // Buffered tokens from the scanner to allow going forward and back in the stream
 
 	logConf log.Config
@@ -43,7 +55,24 @@ type Parser struct {
 
 // New returns a fresh parser Parser.
 func NewParser(name, text string, logConf log.Config) (*Parser, error) {
+	return NewParserWithConfig(name, text, Config{}, logConf)
+}
+
+// NewParserFromBytes returns a fresh parser Parser for the input in the encoding of config.InputEncoding. The input is
+// decoded to UTF-8 before parsing, a DecodeError is returned if it is not valid in its encoding.
+func NewParserFromBytes(name string, input []byte, config Config, logConf log.Config) (*Parser, error) {
+	text, err := decode(input, config.InputEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return NewParserWithConfig(name, text, config, logConf)
+}
+
+// NewParserWithConfig returns a fresh parser Parser using the settings in config. A byte order mark at the start of text is
+// removed and line endings are converted to newlines.
+func NewParserWithConfig(name, text string, config Config, logConf log.Config) (*Parser, error) {
 	var ntext string
+	text = normalizeLineEndings(strings.TrimPrefix(text, "\ufeff"))
 	if !norm.NFC.IsNormalString(text) {
 		ntext = norm.NFC.String(text)
 	} else {
@@ -53,26 +82,31 @@ func NewParser(name, text string, logConf log.Config) (*Parser, error) {
 	conf := logConf
 	conf.Name = "parser"
 
-	l, err := tok.Lex(name, []byte(ntext), conf)
+	l, err := tok.Lex(name, []byte(ntext), config.tabWidth(), conf)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing lexer: %s", err)
 	}
 
 	ml := make(doc.NodeList, 0)
-	nl := make(doc.NodeList, 0)
+	d := doc.NewDocument()
+	nl := &d.NodeList
 	p := &Parser{
 		Name:            name,
+		Document:        d,
 		Messages:        &ml,
-		Nodes:           &nl,
-		text:            ntext,
+		Nodes:           nl,
+		Config:          config,
+		text:            l.Input(),
+		source:          &sourceText{lines: strings.Split(l.Source(), "\n")},
 		lex:             l,
 		logConf:         conf,
+		blockQuotes:     new(blockQuoteLevels),
 		sectionLevels:   newSectionLevels(conf),
 		sectionSubState: new(sectionParseSubState),
-		indents:         new(indentQueue),
-		nodeTarget:      doc.NewNodeTarget(&nl, conf),
+		nodeTarget:      doc.NewNodeTarget(nl, conf),
 		Logger:          log.NewLogger(conf),
 		tokenBuffer:     newTokenBuffer(l, conf),
+		roles:           make(map[string]roleFunc),
 	}
 
 	p.Msgr("Parser.Nodes pointer", "nodeListPointer", fmt.Sprintf("%p", nl))
@@ -83,8 +117,6 @@ func NewParser(name, text string, logConf log.Config) (*Parser, error) {
 // Parse starts parsing the document.
 func (p *Parser) Parse() {
 	for {
-		var n doc.Node
-
 		token := p.next(1)
 		p.printToken("Parser got token", token)
 		// if token.Line == 7 && token.Type == tok.Text && token.Text == "-----" {
@@ -108,42 +140,29 @@ func (p *Parser) Parse() {
 		case tok.InlineInterpretedTextRoleOpen:
 			p.inlineInterpretedTextRole(token)
 		case tok.Transition:
-			// FIXME: Workaround until transitions are supported
-			p.nodeTarget.Append(doc.NewTransition(token))
+			p.transition(token)
 		case tok.CommentMark:
 			p.comment(token)
+		case tok.DirectiveMark:
+			p.directive(token)
+		case tok.HyperlinkTargetStart:
+			p.hyperlinkTarget(token)
 		case tok.SectionAdornment:
 			p.section(token)
 			// p.DumpExit(p.buf)
-		case tok.EnumListArabic:
-			n = p.enumList(token)
-			// FIXME: This is only until enumerated list are properly implemented.
-			if n == nil {
-				continue
-			}
-			p.nodeTarget.Append(n)
+		case tok.EnumListAffix, tok.EnumListArabic, tok.EnumListAlpha, tok.EnumListRoman, tok.EnumListAuto:
+			p.enumList(token)
 		case tok.Space:
-			//
-			//  FIXME: Blockquote parsing is NOT fully implemented.
-			//
-			if p.peekBack(1).Type == tok.BlankLine && p.bqLevel == nil {
-				// Ignore if next item is a blockquote from the lexer
-				if pn := p.peek(1); pn != nil && pn.Type == tok.BlockQuote {
-					p.Msg("Next item is blockquote; not creating empty blockquote")
-					continue
-				}
-				p.Msg("Creating empty blockquote!")
-				p.emptyblockquote(token)
-			} else if p.peekBack(1).Type == tok.BlankLine {
-				p.nodeTarget.SetParent(p.bqLevel)
+			if p.isIndented(token) {
+				p.blockQuote(token)
 			}
 		case tok.BlankLine, tok.Title, tok.Escape:
 			// itemTitle is consumed when evaluating SectionAdornment
 			continue
 		case tok.BlockQuote:
-			p.blockquote(token)
+			p.blockQuote(token)
 		case tok.DefinitionTerm:
-			p.definitionTerm(token)
+			p.definitionList(token)
 		case tok.Bullet:
 			p.bulletList(token)
 		default:
@@ -151,6 +170,29 @@ func (p *Parser) Parse() {
 		}
 
 	}
+	if !p.subParser {
+		p.registerTargets()
+		if p.Config.DocTitle {
+			p.addTransform(transformPriorityDocTitle, docTitle)
+		}
+		p.addTransform(transformPriorityTransitions, transitions)
+	}
+	p.applyTransforms()
+	if !p.subParser {
+		p.extendSpans()
+	}
+}
+
+// extendSpans extends the spans of the nodes of the document and of the system messages to include their contents. System
+// messages of the main document are located at their line and start position.
+func (p *Parser) extendSpans() {
+	doc.ExtendSpans(p.Document)
+	for _, n := range *p.Messages {
+		if m, ok := n.(*doc.SystemMessageNode); ok && m.Source == "" && m.Line > 0 {
+			m.Span.Extend(doc.NewSpan(p.lex.Locate(&tok.Item{Line: m.Line, StartPosition: m.StartPosition})))
+		}
+		doc.ExtendSpans(n)
+	}
 }
 
 func (p *Parser) subParseBodyElements(token *tok.Item) doc.Node {
@@ -171,12 +213,21 @@ func (p *Parser) subParseBodyElements(token *tok.Item) doc.Node {
 		p.inlineInterpretedTextRole(token)
 	case tok.CommentMark:
 		p.comment(token)
-	case tok.EnumListArabic:
+	case tok.DirectiveMark:
+		p.directive(token)
+	case tok.EnumListAffix, tok.EnumListArabic, tok.EnumListAlpha, tok.EnumListRoman, tok.EnumListAuto:
 		p.enumList(token)
+	case tok.Bullet:
+		p.bulletList(token)
+	case tok.DefinitionTerm:
+		p.definitionList(token)
 	case tok.Space:
+		if p.isIndented(token) {
+			p.blockQuote(token)
+		}
 	case tok.BlankLine, tok.Escape:
 	case tok.BlockQuote:
-		p.blockquote(token)
+		p.blockQuote(token)
 	default:
 		p.Msg(fmt.Sprintf("Token type: %q is not yet supported in the parser", token.Type.String()))
 	}
@@ -201,6 +252,12 @@ func (p *Parser) subParseInlineMarkup(token *tok.Item) {
 	}
 }
 
+// extendItem extends the location of the item i to the end of the item e. It is used when the text of e is appended to
+// the text of i.
+func extendItem(i, e *tok.Item) {
+	i.EndOffset, i.EndLine, i.EndColumn = e.EndOffset, e.EndLine, e.EndColumn
+}
+
 func (p *Parser) printToken(msg string, i *tok.Item) {
 	log.WithCallDepth(p.Logger, p.Logger.CallDepth+1).Msgr(msg,
 		"index", p.index,
@@ -2,6 +2,8 @@ package parser
 
 import (
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/demizer/go-rst/pkg/testutil"
@@ -70,12 +72,14 @@ func LoadParserTest(t *testing.T, path string) (test *testutil.Test) {
 	}
 }
 
-// parseTest initiates the parser and parses a test using test.data is input.
+// parseTest initiates the parser and parses a test using test.data is input. Files included by the test are read from the
+// directory of the test.
 func parseTest(t *testing.T, test *testutil.Test) *Parser {
 	p, err := NewParser(test.Path, test.Data, testutil.LoggerConfig)
 	if err != nil {
 		panic(err)
 	}
+	p.Config.FS = os.DirFS(filepath.Dir(test.Path))
 	p.Msgr("test path", "path", test.Path)
 	p.Msgr("test input", "input", test.Data)
 	p.Parse()
@@ -0,0 +1,81 @@
+package parser
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func init() {
+	registerDirective("raw", &directive{
+		requiredArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec: map[string]optionFunc{
+			"file":     unchangedRequiredOption,
+			"encoding": encodingOption,
+			"class":    classOption,
+		},
+		hasContent: true,
+		run:        rawDirective,
+	})
+	registerRole("raw", rawRole(""))
+}
+
+// rawDirective creates a RawNode from the content of the directive or from the file given by the file option. The
+// argument is the space separated list of output formats of the content. The file is read from the configured file
+// system.
+func rawDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if p.Config.DisableRaw {
+		return nil, newDirectiveError(mes.DirectiveWarningDirectiveDisabled, d.name)
+	}
+	format := strings.ToLower(strings.Join(strings.Fields(d.arguments[0]), " "))
+	text := strings.Join(d.content, "\n")
+	line := d.contentLine
+	if d.hasOption("file") {
+		if len(d.content) > 0 {
+			return nil, newDirectiveError(mes.DirectiveErrorFileAndContent, d.name)
+		}
+		if p.Config.FS == nil {
+			return nil, newDirectiveError(mes.DirectiveWarningDirectiveDisabled, d.name)
+		}
+		name, err := p.includePath(d.options["file"])
+		if err != nil {
+			return nil, newDirectiveError(mes.DirectiveSeverePath, d.name, err.Error())
+		}
+		if text, err = p.readFile(d, name); err != nil {
+			return nil, err
+		}
+		line = d.line
+	} else if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+	r := doc.NewRaw(format, p.lex.Locate(&tok.Item{
+		Text:          text,
+		Length:        utf8.RuneCountInString(text),
+		Line:          line,
+		StartPosition: d.startPosition,
+	}))
+	r.Classes = d.classes()
+	return doc.NodeList{r}, nil
+}
+
+// rawRole returns a role that creates a RawNode for format containing the text with the classes. The raw role itself has
+// no format and may only be used as the base of roles defined with the role directive.
+func rawRole(format string, classes ...string) roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		if p.Config.DisableRaw {
+			p.roleMessage(mes.RoleErrorRawDisabled, n)
+			return doc.NodeList{n}
+		}
+		if format == "" {
+			p.roleMessage(mes.RoleErrorRawDirectUse, n)
+			return doc.NodeList{n}
+		}
+		r := doc.NewRaw(strings.ToLower(format), roleItem(n))
+		r.Classes = classes
+		return doc.NodeList{r}
+	}
+}
@@ -0,0 +1,33 @@
+package parser
+
+import (
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestRawDisabled(t *testing.T) {
+	input := ".. raw:: html\n\n   <hr>\n\n.. role:: raw-html(raw)\n   :format: html\n\nA :raw-html:`<br>` break."
+	p, err := NewParser("test", input, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Config.DisableRaw = true
+	p.Parse()
+	expect := []string{"DirectiveWarningDirectiveDisabled", "RoleErrorRawDisabled"}
+	if len(*p.Messages) != len(expect) {
+		t.Fatalf("got %d messages, expect %d", len(*p.Messages), len(expect))
+	}
+	for i, m := range *p.Messages {
+		if sm := m.(*doc.SystemMessageNode); sm.MessageType != expect[i] {
+			t.Errorf("got message %s, expect %s", sm.MessageType, expect[i])
+		}
+	}
+	(*p.Nodes).Walk(func(n doc.Node) bool {
+		if _, ok := n.(*doc.RawNode); ok {
+			t.Error("expect no raw nodes")
+		}
+		return true
+	})
+}
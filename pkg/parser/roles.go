@@ -0,0 +1,293 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// roleFunc interprets the text of an interpreted text role and returns the nodes that replace it.
+type roleFunc func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList
+
+// roles contains the interpreted text roles known to the parser by role name. Roles are added to the map using
+// registerRole from the init function of the file implementing the role.
+var roles = make(map[string]roleFunc)
+
+func registerRole(name string, r roleFunc) { roles[name] = r }
+
+func init() {
+	registerDirective("role", &directive{
+		requiredArguments: 1,
+		optionSpec: map[string]optionFunc{
+			"class":    classOption,
+			"format":   unchangedRequiredOption,
+			"language": unchangedRequiredOption,
+		},
+		run: roleDirective,
+	})
+	registerDirective("default-role", &directive{
+		optionalArguments: 1,
+		run:               defaultRoleDirective,
+	})
+
+	emphasis := textRole(func(i *tok.Item) doc.Node { return doc.NewInlineEmphasis(i) })
+	strong := textRole(func(i *tok.Item) doc.Node { return doc.NewInlineStrong(i) })
+	literal := textRole(func(i *tok.Item) doc.Node { return doc.NewInlineLiteral(i) })
+	subscript := textRole(func(i *tok.Item) doc.Node { return doc.NewSubscript(i) })
+	superscript := textRole(func(i *tok.Item) doc.Node { return doc.NewSuperscript(i) })
+	titleReference := textRole(func(i *tok.Item) doc.Node { return doc.NewTitleReference(i) })
+	abbreviation := textRole(func(i *tok.Item) doc.Node { return doc.NewAbbreviation(i) })
+	for name, r := range map[string]roleFunc{
+		"emphasis":        emphasis,
+		"strong":          strong,
+		"literal":         literal,
+		"subscript":       subscript,
+		"sub":             subscript,
+		"superscript":     superscript,
+		"sup":             superscript,
+		"title-reference": titleReference,
+		"title":           titleReference,
+		"t":               titleReference,
+		"abbreviation":    abbreviation,
+		"ab":              abbreviation,
+		"acronym":         abbreviation,
+		"ac":              abbreviation,
+		"pep-reference":   pepRole,
+		"pep":             pepRole,
+		"rfc-reference":   rfcRole,
+		"rfc":             rfcRole,
+	} {
+		registerRole(name, r)
+	}
+}
+
+// Role contains the interpreted text passed to a RoleHandler.
+type Role struct {
+	Name          string // The name of the role as used in the document
+	Text          string // The interpreted text
+	Line          int
+	StartPosition int
+}
+
+// RoleHandler interprets the text of an interpreted text role and returns the nodes that replace it. If the text cannot
+// be interpreted, the handler returns an error that is added to the document as an error system message. System message
+// nodes returned by the handler are moved to the system messages of the document.
+type RoleHandler func(r Role) (doc.NodeList, error)
+
+// RegisterRole adds the interpreted text role name to all parsers. Role names are case insensitive. RegisterRole is not
+// safe for concurrent use and should be called from an init function.
+func RegisterRole(name string, h RoleHandler) { registerRole(strings.ToLower(name), h.roleFunc()) }
+
+// RegisterRole adds the interpreted text role name to the parser. Roles registered with the parser take precedence over
+// the standard roles and roles registered with the RegisterRole function.
+func (p *Parser) RegisterRole(name string, h RoleHandler) {
+	p.roles[strings.ToLower(name)] = h.roleFunc()
+}
+
+// roleFunc returns a roleFunc calling the handler.
+func (h RoleHandler) roleFunc() roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		name := roleName(n)
+		nodes, err := h(Role{Name: name, Text: n.Text, Line: n.Line, StartPosition: n.StartPosition})
+		if err != nil {
+			p.roleMessage(mes.RoleErrorHandler, n, name, err.Error())
+			return doc.NodeList{n}
+		}
+		var nl doc.NodeList
+		for _, c := range nodes {
+			if sm, ok := c.(*doc.SystemMessageNode); ok {
+				p.Messages.Append(sm)
+				continue
+			}
+			nl = append(nl, c)
+		}
+		return nl
+	}
+}
+
+// roleName returns the name of the role of the interpreted text n. If n has no explicit role, an empty string is returned.
+func roleName(n *doc.InlineInterpretedText) string {
+	for _, c := range n.NodeList {
+		if r, ok := c.(*doc.InlineInterpretedTextRole); ok {
+			return r.Text
+		}
+	}
+	return ""
+}
+
+// role returns the role called name. Roles defined in the document by the role directive take precedence over registered
+// roles. Role names are case insensitive.
+func (p *Parser) role(name string) (roleFunc, bool) {
+	name = strings.ToLower(name)
+	if r, ok := p.roles[name]; ok {
+		return r, true
+	}
+	r, ok := roles[name]
+	return r, ok
+}
+
+// defaultRole is the name the role set by the default-role directive is stored under in the roles of the parser. Storing
+// it with the roles defined by role directives shares it with the parsers of nested content.
+const defaultRole = ""
+
+// interpretRole returns the nodes for the interpreted text n. If the role of n is not known, an error is generated and n is
+// returned unchanged. Interpreted text without a role uses the role set by the default-role directive, if there is none n
+// is returned unchanged and is rendered as a title reference.
+func (p *Parser) interpretRole(n *doc.InlineInterpretedText) doc.NodeList {
+	name := roleName(n)
+	if r, ok := p.role(name); ok {
+		return r(p, n)
+	}
+	if name != defaultRole {
+		p.roleMessage(mes.RoleErrorUnknownRole, n, name)
+	}
+	return doc.NodeList{n}
+}
+
+// roleItem returns a token item with the text, position and location of the interpreted text n.
+func roleItem(n *doc.InlineInterpretedText) *tok.Item {
+	return &tok.Item{Text: n.Text, Length: n.Length, Line: n.Line, StartPosition: n.StartPosition, Offset: n.Span.Offset,
+		EndOffset: n.Span.EndOffset, Column: n.Span.Column, EndLine: n.Span.EndLine, EndColumn: n.Span.EndColumn}
+}
+
+// textRole returns a role that creates a single node containing the interpreted text using newNode.
+func textRole(newNode func(i *tok.Item) doc.Node) roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		return doc.NodeList{newNode(roleItem(n))}
+	}
+}
+
+// pepRole creates a reference to a Python Enhancement Proposal. The text is the number of the PEP.
+func pepRole(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+	num, err := strconv.Atoi(n.Text)
+	if err != nil || num < 0 || num > 9999 {
+		p.roleMessage(mes.RoleErrorPEPNumber, n, n.Text)
+		return doc.NodeList{n}
+	}
+	i := roleItem(n)
+	i.Text = "PEP " + n.Text
+	i.Length += 4
+	return doc.NodeList{doc.NewExternalReference(fmt.Sprintf(pepURL, num), doc.NodeList{doc.NewText(i)})}
+}
+
+// rfcRole creates a reference to a Request For Comments. The text is the number of the RFC optionally followed by an
+// anchor, i.e., "2822#section-3".
+func rfcRole(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+	text, anchor := n.Text, ""
+	if i := strings.Index(text, "#"); i >= 0 {
+		text, anchor = text[:i], text[i:]
+	}
+	num, err := strconv.Atoi(text)
+	if err != nil || num < 1 {
+		p.roleMessage(mes.RoleErrorRFCNumber, n, n.Text)
+		return doc.NodeList{n}
+	}
+	i := roleItem(n)
+	i.Text = "RFC " + text
+	i.Length = len(i.Text)
+	return doc.NodeList{doc.NewExternalReference(fmt.Sprintf(rfcURL, num)+anchor, doc.NodeList{doc.NewText(i)})}
+}
+
+const (
+	pepURL = "https://peps.python.org/pep-%04d/"
+	rfcURL = "https://tools.ietf.org/html/rfc%d.html"
+)
+
+// genericRole returns a role that creates an InlineNode containing the text with the classes.
+func genericRole(classes ...string) roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		return doc.NodeList{doc.NewInline(n.Text, classes...)}
+	}
+}
+
+// derivedRole returns a role that creates the nodes of the base role with the classes of the derived role.
+func derivedRole(base roleFunc, classes []string) roleFunc {
+	return func(p *Parser, n *doc.InlineInterpretedText) doc.NodeList {
+		nodes := base(p, n)
+		for _, c := range nodes {
+			switch t := c.(type) {
+			case *doc.InlineNode:
+				t.Classes = classes
+			case *doc.InlineLiteralNode:
+				t.Classes = append(t.Classes, classes...)
+			case *doc.InlineEmphasisNode:
+				t.Classes = classes
+			case *doc.InlineStrongNode:
+				t.Classes = classes
+			case *doc.SubscriptNode:
+				t.Classes = classes
+			case *doc.SuperscriptNode:
+				t.Classes = classes
+			case *doc.TitleReferenceNode:
+				t.Classes = classes
+			case *doc.AbbreviationNode:
+				t.Classes = classes
+			case *doc.ReferenceNode:
+				t.Classes = classes
+			case *doc.RawNode:
+				t.Classes = classes
+			case *doc.MathNode:
+				t.Classes = classes
+			}
+		}
+		return nodes
+	}
+}
+
+var roleArgument = regexp.MustCompile(`^([a-zA-Z0-9](?:[-_.:+]?[a-zA-Z0-9])*) *(?:\( *([a-zA-Z0-9](?:[-_.:+]?[a-zA-Z0-9])*) *\))?$`)
+
+// roleDirective defines a new interpreted text role for the rest of the document. The argument is the name of the new
+// role, optionally followed by the name of a base role in parentheses, i.e., "custom(raw)". Without a base role, the new
+// role creates an InlineNode with the name of the role as its class. The class option replaces the class of the new role.
+// The format option gives the output format of roles based on the raw role and the language option gives the language of
+// roles based on the code role.
+func roleDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	m := roleArgument.FindStringSubmatch(strings.TrimSpace(d.arguments[0]))
+	if m == nil {
+		return nil, fmt.Errorf("\"%s\" is not a valid role name", d.arguments[0])
+	}
+	name, base := strings.ToLower(m[1]), strings.ToLower(m[2])
+	classes := d.classes()
+	if !d.hasOption("class") {
+		classes = []string{doc.MakeID(name)}
+	}
+
+	var r roleFunc
+	switch base {
+	case "":
+		r = genericRole(classes...)
+	case "raw":
+		r = rawRole(d.options["format"], d.classes()...)
+	case "code":
+		r = codeRole(d.options["language"], d.classes()...)
+	default:
+		br, ok := p.role(base)
+		if !ok {
+			return nil, newDirectiveError(mes.RoleErrorUnknownRole, base)
+		}
+		r = derivedRole(br, classes)
+	}
+	p.roles[name] = r
+	return nil, nil
+}
+
+// defaultRoleDirective sets the role of interpreted text without an explicit role for the rest of the document. Without an
+// argument, the standard default role, title-reference, is restored.
+func defaultRoleDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.arguments) == 0 {
+		delete(p.roles, defaultRole)
+		return nil, nil
+	}
+	name := strings.TrimSpace(d.arguments[0])
+	r, ok := p.role(name)
+	if !ok {
+		return nil, newDirectiveError(mes.RoleErrorUnknownRole, name)
+	}
+	p.roles[defaultRole] = r
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+func TestParserRegisterRole(t *testing.T) {
+	p, err := NewParser("test", "An :Issue:`42` and :issue:`x`.", testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	p.RegisterRole("issue", func(r Role) (doc.NodeList, error) {
+		names = append(names, r.Name)
+		if r.Text == "x" {
+			return nil, errors.New("not an issue number")
+		}
+		text := doc.NewText(&tok.Item{Text: "#" + r.Text, Line: r.Line, StartPosition: r.StartPosition})
+		return doc.NodeList{doc.NewExternalReference("https://example.com/issues/"+r.Text, doc.NodeList{text})}, nil
+	})
+	p.Parse()
+
+	if len(names) != 2 || names[0] != "Issue" || names[1] != "issue" {
+		t.Errorf("got role names %q, expect [Issue issue]", names)
+	}
+	para := (*p.Nodes)[0].(*doc.ParagraphNode)
+	ref, ok := para.NodeList[1].(*doc.ReferenceNode)
+	if !ok || ref.RefURI != "https://example.com/issues/42" {
+		t.Errorf("got %#v, expect reference to issue 42", para.NodeList[1])
+	}
+	if _, ok := para.NodeList[3].(*doc.InlineInterpretedText); !ok {
+		t.Errorf("got %#v, expect the invalid interpreted text unchanged", para.NodeList[3])
+	}
+	if len(*p.Messages) != 1 {
+		t.Fatalf("got %d messages, expect 1", len(*p.Messages))
+	}
+	if sm := (*p.Messages)[0].(*doc.SystemMessageNode); sm.MessageType != "RoleErrorHandler" {
+		t.Errorf("got message %s, expect RoleErrorHandler", sm.MessageType)
+	}
+}
@@ -138,9 +138,6 @@ func Test_00_00_06_01_ParserCommentBad(t *testing.T) {
 }
 
 func Test_01_00_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.00.00-target")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -148,9 +145,6 @@ func Test_01_00_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_00_00_01_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.00.01-optional-space-before-colon")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -168,9 +162,6 @@ func Test_01_00_00_02_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_00_03_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.00.03-across-lines")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -188,15 +179,19 @@ func Test_01_00_00_04_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_01_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.01.00-long-target-names")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_01_00_01_01_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.00.01.01-target-name-inner-white-space")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_01_00_02_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
 		t.SkipNow()
@@ -218,9 +213,6 @@ func Test_01_00_02_01_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_03_00_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.03.00-bad-duplicate-implicit-targets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -228,9 +220,6 @@ func Test_01_00_03_00_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_03_01_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.03.01-bad-duplicate-implicit-explicit-targets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -248,9 +237,6 @@ func Test_01_00_03_02_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_04_00_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.04.00-bad-duplicate-explicit-targets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -277,6 +263,13 @@ func Test_01_00_04_02_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_01_00_04_03_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.00.04.03-bad-duplicate-explicit-target-directive-name")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_01_00_05_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
 		t.SkipNow()
@@ -298,9 +291,6 @@ func Test_01_00_05_01_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_01_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.00.00-external-target")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -308,15 +298,19 @@ func Test_01_01_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_01_00_01_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.00.01-external-target")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_01_01_00_02_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.01.00.02-consecutive-external-targets")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_01_01_01_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
 		t.SkipNow()
@@ -328,9 +322,6 @@ func Test_01_01_01_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_01_02_00_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.02.00-bad-duplicate-external-targets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -338,9 +329,6 @@ func Test_01_01_02_00_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_01_02_01_ParserReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.02.01-bad-duplicate-external-targets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -378,9 +366,6 @@ func Test_01_01_03_02_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_02_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.02.00.00-indirect-hyperlink-targets-target")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -388,9 +373,6 @@ func Test_01_02_00_00_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_02_00_01_ParserReferenceHyperlinkTargetsGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.02.00.01-indirect-hyperlink-targets-phrase-references")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -465,6 +447,13 @@ func Test_02_00_00_03_ParserParagraphGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_02_00_00_04_ParserParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.00.04-byte-order-mark")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_02_00_01_00_ParserParagraphGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("02.00.01.00-two-paragraphs")
 	test := LoadParserTest(t, testPath)
@@ -479,10 +468,28 @@ func Test_02_00_01_01_ParserParagraphGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_02_00_02_00_ParserParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.02.00-tabs-and-whitespace")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_02_00_03_00_ParserParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.03.00-locations-multibyte")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_02_00_03_01_ParserParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.03.01-locations-tabs")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_03_00_00_00_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.00-paragraph-blockquote")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -490,9 +497,6 @@ func Test_03_00_00_00_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_01_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.01-paragraph-blockquote-short-section")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -500,9 +504,6 @@ func Test_03_00_00_01_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_02_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.02-paragraph-blockquote-comment")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -510,9 +511,6 @@ func Test_03_00_00_02_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_03_ParserBlockquoteBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.03-bad-no-blank-line")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -520,9 +518,6 @@ func Test_03_00_00_03_ParserBlockquoteBad(t *testing.T) {
 }
 
 func Test_03_00_00_04_ParserBlockquoteBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.04-bad-unexpected-indent")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -530,9 +525,6 @@ func Test_03_00_00_04_ParserBlockquoteBad(t *testing.T) {
 }
 
 func Test_03_00_01_00_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.01.00-two-levels")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -540,9 +532,6 @@ func Test_03_00_01_00_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_02_00_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.02.00-unicode-em-dash")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -550,9 +539,6 @@ func Test_03_00_02_00_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_03_00_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.03.00-uneven-indents")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -560,9 +546,6 @@ func Test_03_00_03_00_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_00_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.00-paragraph-blockquote-attrib")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -570,9 +553,6 @@ func Test_03_00_04_00_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_01_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.01-paragraph-blockquote-two-line-attrib")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -580,9 +560,6 @@ func Test_03_00_04_01_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_02_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.02-paragraph-blockquote-attrib-no-space")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -590,9 +567,6 @@ func Test_03_00_04_02_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_03_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.03-paragraph-blockquote-one-attrib")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -600,9 +574,6 @@ func Test_03_00_04_03_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_04_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.04-paragraph-blockquote-attrib-invalid")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -610,9 +581,6 @@ func Test_03_00_04_04_ParserBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_05_ParserBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.05-paragraph-blockquote-attrib-with-invalid-attrib")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -668,6 +636,34 @@ func Test_04_00_02_00_ParserSectionGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_04_00_02_01_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.01-one-char-title-dash-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_02_02_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.02-one-char-title-star-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_02_03_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.03-one-char-title-plus-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_02_04_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.04-bad-short-title-one-char-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_04_00_03_00_ParserSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.00.03.00-empty-section")
 	test := LoadParserTest(t, testPath)
@@ -696,6 +692,48 @@ func Test_04_00_05_00_ParserSectionGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_04_00_06_00_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.06.00-title-ids")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_07_00_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.00-title-wide-chars-chinese")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_07_01_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.01-title-wide-chars-emoji")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_07_02_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.02-title-wide-chars-japanese")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_07_03_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.03-bad-wide-chars-short-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_00_07_04_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.04-title-wide-chars-long-underline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_04_01_00_00_ParserSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.01.00.00-title-overline")
 	test := LoadParserTest(t, testPath)
@@ -818,6 +856,13 @@ func Test_04_01_07_00_ParserSectionBad(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_04_01_08_00_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.01.08.00-title-wide-chars-overline")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_04_02_00_00_ParserSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.02.00.00-section-level-return")
 	test := LoadParserTest(t, testPath)
@@ -874,10 +919,63 @@ func Test_04_02_02_00_ParserSectionGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_04_03_00_00_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.00-transition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_00_01_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.01-bad-document-begins-with-transition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_00_02_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.02-bad-document-ends-with-transition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_00_03_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.03-bad-adjacent-transitions")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_00_04_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.04-mixed-characters-is-text")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_01_00_ParserSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.01.00-transition-at-end-of-section")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_01_01_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.01.01-bad-section-begins-with-transition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_04_03_02_00_ParserSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.02.00-bad-transition-in-block-quote")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_05_00_00_00_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.00-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -885,9 +983,6 @@ func Test_05_00_00_00_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_01_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.01-literal-block-space-after-colons")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -895,9 +990,6 @@ func Test_05_00_00_01_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_02_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.02-bad-unindented-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -905,9 +997,6 @@ func Test_05_00_00_02_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_03_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.03-bad-no-blankline-after-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -915,9 +1004,6 @@ func Test_05_00_00_03_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_04_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.04-multiline-paragraph-before-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -925,9 +1011,6 @@ func Test_05_00_00_04_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_05_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.05-bad-no-blankline-before-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -935,9 +1018,6 @@ func Test_05_00_00_05_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_06_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.06-paragraph-space-double-colon-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -945,9 +1025,6 @@ func Test_05_00_00_06_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_07_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.07-paragraph-colon-newline-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -955,9 +1032,6 @@ func Test_05_00_00_07_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_08_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.08-bad-section-underline-not-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -965,9 +1039,6 @@ func Test_05_00_00_08_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_09_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.09-bad-eof-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -975,9 +1046,6 @@ func Test_05_00_00_09_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_01_00_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.01.00-multiline-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -985,9 +1053,6 @@ func Test_05_00_01_00_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_01_01_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.01.01-wonky-multiline-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -995,9 +1060,6 @@ func Test_05_00_01_01_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_02_00_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.02.00-double-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1005,19 +1067,20 @@ func Test_05_00_02_00_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_02_01_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.02.01-literal-block-and-escaped-colon-blockquote")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
-func Test_05_01_00_00_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
+func Test_05_00_03_00_ParserLiteralBlockGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("05.00.03.00-literal-block-with-tabs")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_05_01_00_00_ParserLiteralBlockGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("05.01.00.00-quoted-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1025,9 +1088,6 @@ func Test_05_01_00_00_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_00_01_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.00.01-quoted-literal-block-two-blanklines")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1035,9 +1095,6 @@ func Test_05_01_00_01_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_00_02_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.00.02-bad-inconsistent-quoted-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1045,9 +1102,6 @@ func Test_05_01_00_02_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_01_01_00_ParserLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.00-quoted-literal-block-multiline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1055,9 +1109,6 @@ func Test_05_01_01_00_ParserLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_01_01_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.01-bad-indented-line-after-quoted-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -1065,9 +1116,6 @@ func Test_05_01_01_01_ParserLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_01_01_02_ParserLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.02-bad-unindented-line-after-quoted-literal-block")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2049,10 +2097,63 @@ func Test_06_11_03_00_ParserInlineMarkupGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_06_12_00_00_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.00-standard-roles")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_00_01_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.01-sub-and-superscript-roles")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_00_02_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.02-title-and-abbreviation-roles")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_00_03_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.03-pep-and-rfc-roles")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_00_04_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.04-derived-role-classes")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_00_05_ParserInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.05-role-starts-paragraph")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_01_00_ParserInlineMarkupBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.01.00-bad-unknown-role")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_06_12_01_01_ParserInlineMarkupBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.01.01-bad-pep-number")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_07_00_00_00_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.00-bullet-list")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2060,9 +2161,6 @@ func Test_07_00_00_00_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_01_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.01-bullet-list-with-two-items")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2070,9 +2168,6 @@ func Test_07_00_00_01_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_02_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.02-bullet-list-noblankline-between-items")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2080,9 +2175,6 @@ func Test_07_00_00_02_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_03_ParserListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.03-bad-bullet-list-noblankline-at-end")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2090,9 +2182,6 @@ func Test_07_00_00_03_ParserListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_01_00_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.01.00-bullet-list-item-with-paragraph")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2100,9 +2189,6 @@ func Test_07_00_01_00_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_01_01_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.01.01-bullet-list-item-with-paragraph")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2110,9 +2196,6 @@ func Test_07_00_01_01_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_02_00_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.02.00-bullet-list-different-bullets")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2120,9 +2203,6 @@ func Test_07_00_02_00_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_02_01_ParserListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.02.01-bad-bullet-list-different-bullets-missing-blankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2130,9 +2210,6 @@ func Test_07_00_02_01_ParserListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_03_00_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.03.00-bullet-list-empty-item")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2140,9 +2217,6 @@ func Test_07_00_03_00_ParserListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_03_01_ParserListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.03.01-bad-bullet-list-empty-item-noblankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2150,19 +2224,20 @@ func Test_07_00_03_01_ParserListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_04_00_ParserListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.04.00-bullet-list-unicode")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_07_00_05_00_ParserListBulletGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("07.00.05.00-bullet-list-nested")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
 func Test_08_00_00_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.00-numbered")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2170,9 +2245,6 @@ func Test_08_00_00_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_01_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.01-numbered-noblanklines")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2180,9 +2252,6 @@ func Test_08_00_00_01_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_02_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.02-numbered-indented-items")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2190,9 +2259,6 @@ func Test_08_00_00_02_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_03_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.03-bad-enum-list-empty-item-noblankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2200,9 +2266,6 @@ func Test_08_00_00_03_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_04_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.04-bad-enum-list-scrambled-items")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2210,9 +2273,6 @@ func Test_08_00_00_04_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_05_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.05-bad-enum-list-skipped-item")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2220,9 +2280,6 @@ func Test_08_00_00_05_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_06_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.06-bad-enum-list-not-ordinal-1")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2230,9 +2287,6 @@ func Test_08_00_00_06_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_01_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.00-alphabetical-list")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2240,9 +2294,6 @@ func Test_08_00_01_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_01_01_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.01-bad-alphabetical-list-without-blankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2250,9 +2301,6 @@ func Test_08_00_01_01_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_01_02_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.02-alphabetical-list-nbsp-workaround")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2260,9 +2308,6 @@ func Test_08_00_01_02_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_02_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.02.00-items-with-paragraphs")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2270,9 +2315,6 @@ func Test_08_00_02_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_02_01_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.02.01-bad-enum-list-unexpected-unindent")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2280,9 +2322,6 @@ func Test_08_00_02_01_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_03_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.03.00-diff-formats")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2290,9 +2329,6 @@ func Test_08_00_03_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_04_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.04.00-roman-numerals")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2300,9 +2336,6 @@ func Test_08_00_04_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_04_01_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.04.01-bad-enum-list-bad-roman-numerals")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2310,9 +2343,6 @@ func Test_08_00_04_01_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_05_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.05.00-nested")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2320,9 +2350,6 @@ func Test_08_00_05_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.00-sequence-types")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2330,9 +2357,6 @@ func Test_08_00_06_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_01_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.01-ambiguous-sequence-types")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2340,9 +2364,6 @@ func Test_08_00_06_01_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_02_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.02-bad-enum-list-ambiguous")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2350,9 +2371,6 @@ func Test_08_00_06_02_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_07_00_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.00-auto-numbering")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2360,9 +2378,6 @@ func Test_08_00_07_00_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_01_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.01-auto-numbering")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2370,9 +2385,6 @@ func Test_08_00_07_01_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_02_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.02-auto-numbering")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2380,9 +2392,6 @@ func Test_08_00_07_02_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_03_ParserListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.03-auto-numbering")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2390,9 +2399,6 @@ func Test_08_00_07_03_ParserListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_04_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.04-bad-enum-list-auto-numbering-noblankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2400,9 +2406,6 @@ func Test_08_00_07_04_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_08_00_ParserListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.08.00-bad-enum-list-paragraph-not-list")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2410,9 +2413,6 @@ func Test_08_00_08_00_ParserListEnumeratedBad(t *testing.T) {
 }
 
 func Test_09_00_00_01_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.01-with-paragraph")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2420,9 +2420,6 @@ func Test_09_00_00_01_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_00_02_ParserListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.02-bad-def-list-noblankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2430,9 +2427,6 @@ func Test_09_00_00_02_ParserListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_00_03_ParserListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.03-bad-def-list-not-def-term")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2440,9 +2434,6 @@ func Test_09_00_00_03_ParserListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_01_00_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.00-two-terms")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2450,9 +2441,6 @@ func Test_09_00_01_00_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_01_01_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.01-two-terms-noblankline")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2460,9 +2448,6 @@ func Test_09_00_01_01_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_01_02_ParserListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.02-bad-def-list-noblankline-after-two-terms")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2470,9 +2455,6 @@ func Test_09_00_01_02_ParserListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_02_00_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.02.00-nested-terms")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2480,9 +2462,6 @@ func Test_09_00_02_00_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_03_00_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.03.00-term-with-classifier")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2490,9 +2469,6 @@ func Test_09_00_03_00_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_00_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.00-term-not-classifier")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2500,9 +2476,6 @@ func Test_09_00_04_00_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_01_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.01-term-not-classifier-literal")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2510,9 +2483,6 @@ func Test_09_00_04_01_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_02_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.02-two-classifiers")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2520,9 +2490,6 @@ func Test_09_00_04_02_ParserListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_05_00_ParserListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.05.00-not-literal")
 	test := LoadParserTest(t, testPath)
 	pTree := parseTest(t, test)
@@ -2549,3 +2516,661 @@ func Test_10_00_00_00_ParserListOptionGood(t *testing.T) {
 	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
 }
 
+func Test_11_00_00_00_ParserDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.00-note")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_00_01_ParserDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.01-all-admonitions")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_00_02_ParserDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.02-note-with-options")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_01_00_ParserDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.01.00-generic-admonition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_01_01_ParserDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.01.01-generic-admonition-with-class")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_02_00_ParserDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.00-bad-empty-admonition")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_02_01_ParserDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.01-bad-generic-admonition-without-title")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_02_02_ParserDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.02-bad-unknown-option")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_11_00_02_03_ParserDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.03-bad-unknown-directive")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_00_00_ParserDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.00-code-go")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_00_01_ParserDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.01-code-shell-number-lines")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_00_02_ParserDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.02-code-json-yaml")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_00_03_ParserDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.03-code-no-language")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_01_00_ParserDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.01.00-code-role")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_02_00_ParserDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.00-bad-code-no-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_02_01_ParserDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.01-bad-code-unknown-language")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_12_00_02_02_ParserDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.02-bad-code-number-lines-not-integer")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_00_00_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.00-image")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_00_01_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.01-image-options")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_00_02_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.02-image-uri-whitespace")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_01_00_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.00-figure")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_01_01_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.01-figure-no-caption")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_01_02_ParserDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.02-figure-legend-only")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_00_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.00-bad-image-width-unit")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_01_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.01-bad-image-height-percentage")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_02_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.02-bad-image-align")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_03_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.03-bad-figure-align")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_04_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.04-bad-image-scale-negative")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_05_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.05-bad-image-no-uri")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_13_00_02_06_ParserDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.06-bad-figure-caption")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_00_00_ParserDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.00-contents")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_00_01_ParserDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.01-contents-title-depth-backlinks")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_00_02_ParserDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.02-contents-local")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_00_03_ParserDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.03-contents-no-sections")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_00_04_ParserDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.04-contents-duplicate-ids")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_01_00_ParserDirectiveContentsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.01.00-bad-contents-depth")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_14_00_01_01_ParserDirectiveContentsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.01.01-bad-contents-backlinks")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_15_00_00_00_ParserDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.00-sectnum")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_15_00_00_01_ParserDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.01-sectnum-options")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_15_00_00_02_ParserDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.02-sectnum-contents")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_15_00_01_00_ParserDirectiveSectnumBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.01.00-bad-sectnum-start")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_15_00_01_01_ParserDirectiveSectnumBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.01.01-bad-sectnum-prefix-empty")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_00_00_ParserDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.00-include")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_00_01_ParserDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.01-include-section")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_00_02_ParserDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.02-include-literal-lines")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_00_03_ParserDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.03-include-start-after-end-before")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_00_04_ParserDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.04-include-code")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_00_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.00-bad-include-missing-file")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_01_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.01-bad-include-recursion")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_02_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.02-bad-include-indirect-recursion")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_03_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.03-bad-include-text-not-found")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_04_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.04-bad-include-message-source")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_16_00_01_05_ParserDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.05-bad-include-outside-fs")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_00_00_ParserDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.00-raw")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_00_01_ParserDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.01-raw-multiple-formats")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_00_02_ParserDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.02-raw-file")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_00_03_ParserDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.03-raw-role")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_00_04_ParserDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.04-role-generic")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_01_00_ParserDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.00-bad-raw-role-direct-use")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_01_01_ParserDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.01-bad-raw-file-and-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_01_02_ParserDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.02-bad-raw-no-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_17_00_01_03_ParserDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.03-bad-role-unknown-base")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_00_00_ParserDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.00-math")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_00_01_ParserDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.01-math-multiple-blocks")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_00_02_ParserDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.02-math-directive-line")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_00_03_ParserDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.03-math-role")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_00_04_ParserDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.04-math-greek-and-matrix")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_18_00_01_00_ParserDirectiveMathBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.01.00-bad-math-no-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_00_00_ParserDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.00-list-table")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_00_01_ParserDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.01-list-table-stub-columns")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_00_02_ParserDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.02-csv-table")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_00_03_ParserDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.03-csv-table-delim-header-rows")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_00_04_ParserDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.04-csv-table-file")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_00_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.00-bad-list-table-ragged-rows")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_01_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.01-bad-list-table-not-two-level")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_02_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.02-bad-list-table-widths")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_03_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.03-bad-csv-table-ragged-rows")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_04_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.04-bad-csv-table-header-rows")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_19_00_01_05_ParserDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.05-bad-list-table-stub-columns")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_00_00_ParserDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.00-class")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_00_01_ParserDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.01-class-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_00_02_ParserDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.02-class-skips-comments")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_00_03_ParserDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.03-class-last-in-section")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_01_00_ParserDirectiveClassBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.01.00-bad-class-no-element")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_20_00_01_01_ParserDirectiveClassBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.01.01-bad-class-no-argument")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_00_00_ParserDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.00-meta")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_00_01_ParserDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.01-meta-multiline-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_00_02_ParserDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.02-title")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_01_00_ParserDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.00-bad-meta-no-content")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_01_01_ParserDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.01-bad-meta-invalid")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_01_02_ParserDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.02-bad-meta-attribute")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_21_00_01_03_ParserDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.03-bad-meta-attribute-name")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_22_00_00_00_ParserDirectiveDefaultRoleGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("22.00.00.00-default-role")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
+func Test_22_00_01_00_ParserDirectiveDefaultRoleBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("22.00.01.00-bad-default-role-unknown")
+	test := LoadParserTest(t, testPath)
+	pTree := parseTest(t, test)
+	checkParseNodes(t, test.ExpectParseData, pTree, testPath)
+}
+
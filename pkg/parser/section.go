@@ -14,6 +14,7 @@ type sectionParseSubState struct {
 	padding   *tok.Item      // The whitespace after a section title and section adornment
 	indent    *tok.Item      // Section indent level
 	indented  bool
+	width     int // The display width of the title, see tok.ColumnWidth
 }
 
 func (p *Parser) sectionTitle() bool {
@@ -56,10 +57,10 @@ func (p *Parser) sectionTitle() bool {
 	} else if hazOverline && overlineIndented {
 		// Overline is indented
 		return p.systemMessage(mes.SectionErrorUnexpectedSectionTitleOrTransition)
-	} else if hazOverline && overline.Length < 4 && overline.Length < title.Length {
+	} else if hazOverline && overline.Length < 4 && overline.Length < tok.ColumnWidth(title.Text) {
 		// Overline is less than three chars. In this case the title may be a text token
 		return p.systemMessage(mes.SectionWarningOverlineTooShortForTitle)
-	} else if hazUnderline && underline.Length < 4 && underline.Length < title.Length {
+	} else if hazUnderline && underline.Length < 4 && underline.Length < tok.ColumnWidth(title.Text) {
 		// Underline is less than three chars. In this case the title may be a text token
 		return p.systemMessage(mes.SectionWarningUnderlineTooShortForTitle)
 	} else if hazOverline && hazUnderline && overlineUnderlineMismatch {
@@ -77,6 +78,7 @@ loop:
 		switch cTok := p.token; cTok.Type {
 		case tok.Title:
 			p.sectionSubState.titleNode = doc.NewTitleNodeWithText(cTok)
+			p.sectionSubState.width = tok.ColumnWidth(cTok.Text)
 			p.next(1)
 		case tok.Space:
 			p.sectionSubState.indent = cTok
@@ -93,11 +95,18 @@ loop:
 func (p *Parser) sectionTitleNoOverline() bool {
 	p.Msg("Parsing section with no overline")
 	s := p.sectionSubState
-
-	if s.indented {
+	s.width = tok.ColumnWidth(s.title.Text)
+
+	if s.indented && s.underline.Length < 4 && s.underline.Length < s.width {
+		// Parse Test 03.00.00.01 :: Indented titles are not possible, a short underline is ordinary text
+		text := s.title.Text + "\n" + s.underline.Text
+		p.insert(p.lex.Locate(&tok.Item{Type: tok.Text, Text: text, Length: len(text), Line: s.title.Line,
+			StartPosition: s.title.StartPosition}), p.index+1)
+		return false
+	} else if s.indented {
 		// The section underline is indented
 		return p.systemMessage(mes.SectionErrorUnexpectedSectionTitle)
-	} else if s.underline.Length < 4 && s.underline.Length < s.title.Length {
+	} else if s.underline.Length < 4 && s.underline.Length < s.width {
 		// Underline too short
 		return p.systemMessage(mes.SectionWarningUnderlineTooShortForTitle)
 	}
@@ -111,7 +120,7 @@ func (p *Parser) sectionTitleNoOverline() bool {
 func (p *Parser) sectionTitleWithInlineMarkupAndNoOverline() bool {
 	p.Msg("Parsing section with inline markup and no overline")
 
-	var titleLen int
+	var titleLen, titleWidth int
 	s := p.sectionSubState
 
 	titleToks := p.peekLineAllTokens(p.token.Line - 1)
@@ -121,6 +130,7 @@ func (p *Parser) sectionTitleWithInlineMarkupAndNoOverline() bool {
 	p.nodeTarget.SetParent(tn)
 	for _, v := range titleToks {
 		titleLen += v.Length
+		titleWidth += tok.ColumnWidth(v.Text)
 		switch v.Type {
 		case tok.Title:
 			tn.Append(doc.NewText(v))
@@ -146,7 +156,7 @@ func (p *Parser) sectionTitleWithInlineMarkupAndNoOverline() bool {
 	// return p.systemMessage(mes.SectionErrorUnexpectedSectionTitle)
 	// }
 
-	if p.token.Length < 4 && p.token.Length != tn.Length {
+	if p.token.Length < 4 && p.token.Length != titleWidth {
 		// Short underline
 		return p.systemMessage(mes.SectionWarningUnderlineTooShortForTitle)
 	}
@@ -154,6 +164,7 @@ func (p *Parser) sectionTitleWithInlineMarkupAndNoOverline() bool {
 	// Section OKAY
 	s.titleNode = tn
 	s.underline = p.token
+	s.width = titleWidth
 
 	return true
 }
@@ -241,14 +252,14 @@ func checkSectionLengths(p *Parser, sec *doc.SectionNode) bool {
 	// The following checks have to be made after the doc.SectionNode has been initialized so that any parserMessages can be
 	// appended to the doc.SectionNode.NodeList.
 	s := p.sectionSubState
-	titleLen := s.titleNode.Length
+	titleWidth := s.width
 	if s.indent != nil {
-		titleLen = s.indent.Length + s.titleNode.Length
+		titleWidth = s.indent.Length + s.width
 	}
 	// p.DumpExit(s)
-	if s.overline != nil && titleLen > s.overline.Length {
+	if s.overline != nil && titleWidth > s.overline.Length {
 		return p.systemMessage(mes.SectionWarningShortOverline)
-	} else if s.overline == nil && s.titleNode.Length != s.underline.Length {
+	} else if s.overline == nil && s.underline.Length < s.width {
 		return p.systemMessage(mes.SectionWarningShortUnderline)
 	}
 	return true
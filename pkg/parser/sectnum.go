@@ -0,0 +1,79 @@
+package parser
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+)
+
+func init() {
+	registerDirective("sectnum", &directive{
+		optionSpec: map[string]optionFunc{
+			"depth":  intOption,
+			"start":  intOption,
+			"prefix": unchangedRequiredOption,
+			"suffix": unchangedRequiredOption,
+		},
+		run: sectNumDirective,
+	})
+	registerDirective("section-numbering", directives["sectnum"])
+}
+
+// intOption validates an integer.
+func intOption(value string) (string, error) {
+	v := strings.TrimSpace(value)
+	if _, err := strconv.Atoi(v); err != nil {
+		return "", errors.New("invalid literal for int(): " + strconv.Quote(v))
+	}
+	return v, nil
+}
+
+// sectNum contains the settings of a sectnum directive used by the section numbering transform.
+type sectNum struct {
+	depth  int // The number of section levels to number
+	start  int // The number of the first top level section
+	prefix string
+	suffix string
+}
+
+// sectNumDirective adds the section numbering transform. The directive does not create any nodes.
+func sectNumDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	s := &sectNum{depth: math.MaxInt32, start: 1, prefix: d.options["prefix"], suffix: d.options["suffix"]}
+	if d.hasOption("depth") {
+		s.depth, _ = strconv.Atoi(d.options["depth"])
+	}
+	if d.hasOption("start") {
+		s.start, _ = strconv.Atoi(d.options["start"])
+	}
+	p.addTransform(transformPrioritySectNum, s.apply)
+	return nil, nil
+}
+
+// apply numbers the sections of the document.
+func (s *sectNum) apply(p *Parser) { s.number(*p.Nodes, nil) }
+
+// number prepends a GeneratedNode with the "sectnum" class containing the section number to the title of each section in
+// nodes. The numbers of nested sections are the numbers of the parent sections followed by the number of the section in
+// the parent, separated by periods. The number is followed by three no-break spaces as in docutils.
+func (s *sectNum) number(nodes doc.NodeList, parent []string) {
+	n := 1
+	if parent == nil {
+		n = s.start
+	}
+	for _, node := range nodes {
+		sec, ok := node.(*doc.SectionNode)
+		if !ok || sec.Title == nil {
+			continue
+		}
+		numbers := append(append([]string{}, parent...), strconv.Itoa(n))
+		text := s.prefix + strings.Join(numbers, ".") + s.suffix + strings.Repeat("\u00a0", 3)
+		sec.Title.NodeList = append(doc.NodeList{doc.NewGenerated(text, "sectnum")}, sec.Title.NodeList...)
+		if len(numbers) < s.depth {
+			s.number(sec.NodeList, numbers)
+		}
+		n++
+	}
+}
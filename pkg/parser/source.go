@@ -0,0 +1,63 @@
+package parser
+
+import (
+	"strings"
+
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// sourceText is the input of a document before whitespace normalization. The lines are the lines of the normalized input,
+// but tabs, form feeds and vertical tabs are kept. Literal blocks and code directives that keep their tabs take their
+// text from it.
+type sourceText struct {
+	lines      []string // The lines of the input
+	lineOffset int      // Added to the index of a line to get its line number in the document, minus one
+}
+
+// block returns count lines beginning at line number line with indent columns of indentation removed. Tabs in the
+// indentation are counted to the next tab stop of tabWidth columns. Nil is returned if the lines are not in the source.
+func (s *sourceText) block(line, count, indent, tabWidth int) []string {
+	start := line - 1 - s.lineOffset
+	if start < 0 || start+count > len(s.lines) {
+		return nil
+	}
+	out := make([]string, count)
+	for i, l := range s.lines[start : start+count] {
+		out[i] = trimColumns(l, indent, tabWidth)
+	}
+	return out
+}
+
+// trimColumns removes n columns of indentation from line. If a tab spans the n-th column, the columns of the tab after
+// the n-th column are replaced with spaces.
+func trimColumns(line string, n, tabWidth int) string {
+	col := 0
+	for i, r := range line {
+		if col >= n {
+			return strings.Repeat(" ", col-n) + line[i:]
+		}
+		switch r {
+		case ' ', '\f', '\v':
+			col++
+		case '\t':
+			col += tabWidth - col%tabWidth
+		default:
+			return line[i:]
+		}
+	}
+	return strings.Repeat(" ", max(col-n, 0))
+}
+
+// literalText returns the text of count lines beginning at line number line, indented indent columns, as it appears in the
+// input before whitespace normalization. If tabWidth is greater than zero, the tabs of the text are expanded with tab
+// stops every tabWidth columns. If the lines are not in the source, text is returned.
+func (p *Parser) literalText(text string, line, count, indent, tabWidth int) string {
+	lines := p.source.block(line, count, indent, p.Config.tabWidth())
+	if lines == nil {
+		return text
+	}
+	if tabWidth > 0 {
+		return tok.ExpandTabs(strings.Join(lines, "\n"), tabWidth)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestTrimColumns(t *testing.T) {
+	tests := []struct {
+		line   string
+		n      int
+		expect string
+	}{
+		{"    text", 4, "text"},
+		{"\ttext", 4, "    text"},
+		{"\t\ttext", 8, "\ttext"},
+		{"  \ttext", 2, "\ttext"},
+		{"  ", 4, ""},
+	}
+	for _, tt := range tests {
+		if got := trimColumns(tt.line, tt.n, 8); got != tt.expect {
+			t.Errorf("trimColumns(%q, %d, 8) = %q, expect %q", tt.line, tt.n, got, tt.expect)
+		}
+	}
+}
+
+func TestKeepLiteralTabs(t *testing.T) {
+	input := "Literal::\n\n\tif x {\n\t\ty\n\t}\n\n.. code::\n\n   a\tb\n\n.. code::\n   :tab-width: -1\n\n   a\tb"
+	tests := []struct {
+		name   string
+		config Config
+		expect []string
+	}{
+		{"Expanded", Config{}, []string{"if x {\n        y\n}", "a    b", "a\tb"}},
+		{"Tab width", Config{TabWidth: 4}, []string{"if x {\n    y\n}", "a    b", "a\tb"}},
+		{"Kept", Config{KeepLiteralTabs: true}, []string{"if x {\n\ty\n}", "a\tb", "a\tb"}},
+	}
+	for _, tt := range tests {
+		p, err := NewParserWithConfig("test", input, tt.config, testutil.LoggerConfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.Parse()
+		var got []string
+		(*p.Nodes).Walk(func(n doc.Node) bool {
+			if lb, ok := n.(*doc.LiteralBlockNode); ok {
+				got = append(got, lb.Text)
+			}
+			return true
+		})
+		if len(got) != len(tt.expect) {
+			t.Fatalf("%s: got %d literal blocks, expect %d", tt.name, len(got), len(tt.expect))
+		}
+		for i := range got {
+			if got[i] != tt.expect[i] {
+				t.Errorf("%s: got literal block %q, expect %q", tt.name, got[i], tt.expect[i])
+			}
+		}
+	}
+}
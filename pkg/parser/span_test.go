@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"testing"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestNodeSpans(t *testing.T) {
+	input := "Größe\n=====\n\n• Erste *Stufe*\n\n  - nested ü item\n\n  Zweite.\n\nEnde ``wört``.\n"
+	p, err := NewParser("test", input, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Parse()
+
+	sec := (*p.Nodes)[0].(*doc.SectionNode)
+	bl := sec.NodeList[0].(*doc.BulletListNode)
+	item := bl.NodeList[0].(*doc.BulletListItemNode)
+	nested := item.NodeList[1].(*doc.BulletListNode)
+	para := sec.NodeList[1].(*doc.ParagraphNode)
+	tests := []struct {
+		name         string
+		node         doc.Node
+		text         string
+		line, column int
+	}{
+		{"section", sec, input[:len(input)-1], 1, 1},
+		{"title", sec.Title, "Größe", 1, 1},
+		{"adornment", sec.UnderLine, "=====", 2, 1},
+		{"bullet list", bl, "• Erste *Stufe*\n\n  - nested ü item\n\n  Zweite.", 4, 1},
+		{"emphasis", item.NodeList[0].(*doc.ParagraphNode).NodeList[1], "*Stufe*", 4, 9},
+		{"nested list", nested, "- nested ü item", 6, 3},
+		{"nested text", nested.NodeList[0].(*doc.BulletListItemNode).NodeList[0], "nested ü item", 6, 5},
+		{"paragraph", para, "Ende ``wört``.", 10, 1},
+		{"literal", para.NodeList[1], "``wört``", 10, 6},
+	}
+	for _, tt := range tests {
+		s := doc.NodeSpan(tt.node)
+		if text := input[s.Offset:s.EndOffset]; text != tt.text || s.Line != tt.line || s.Column != tt.column {
+			t.Errorf("%s: got %q at %d:%d, expect %q at %d:%d", tt.name, text, s.Line, s.Column, tt.text, tt.line,
+				tt.column)
+		}
+	}
+}
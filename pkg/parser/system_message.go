@@ -59,7 +59,9 @@ func (p *Parser) systemMessageSection(s *doc.SystemMessageNode, err *mes.ParserM
 		underline := p.token
 		err.LiteralText = title.Text + "\n" + underline.Text
 		err.MessageLine, err.StartLine, err.EndLine, err.StartPosition = underline.Line, title.Line, underline.Line, underline.StartPosition
-		p.next(1) // Next past the underline
+		if ni := p.peek(1); ni != nil && ni.Type == tok.Space {
+			p.next(1) // Next past the indentation of the following line
+		}
 		// p.DumpExit(p.buf)
 	case mes.SectionErrorInvalidSectionOrTransitionMarker:
 		err.LiteralText = st.overline.Text + "\n" + st.title.Text
@@ -112,13 +114,13 @@ func (p *Parser) systemMessage(err mes.MessageType) bool {
 
 	// Insert text into the next buffer position to be picked up in the next pass of the parser
 	insertText := func() {
-		p.insert(&tok.Item{
+		p.insert(p.lex.Locate(&tok.Item{
 			Type:          tok.Text,
 			Text:          nm.LiteralText,
 			Length:        len(nm.LiteralText),
 			Line:          nm.StartLine,
 			StartPosition: nm.StartPosition,
-		}, p.index+1)
+		}), p.index+1)
 		// p.DumpExit(p.buf[:p.index+3])
 		p.Msgr("foooooooooooooooooooooooooooooooooooooooooooooooooooooooooo", "line", p.token.Line)
 		// if p.token.Line == 16 {
@@ -147,3 +149,122 @@ func (p *Parser) systemMessage(err mes.MessageType) bool {
 	// p.DumpExit(p.Messages)
 	return false
 }
+
+// directiveMessage generates a system message for the directive block d. The literal text of the directive is added to the
+// message. args are substituted into the message text.
+func (p *Parser) directiveMessage(err mes.MessageType, d *directiveBlock, args ...interface{}) {
+	nm := mes.NewParserMessage(err)
+	nm.Args = args
+	nm.LiteralText = d.text
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = d.line, d.line, d.endLine, d.startPosition
+	p.Msgr("Generating directive system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	if len(nm.LiteralText) > 0 {
+		s.Append(doc.NewLiteralBlock(p.lex.Locate(&tok.Item{
+			Text:          nm.LiteralText,
+			Length:        len(nm.LiteralText),
+			Line:          nm.StartLine,
+			StartPosition: nm.StartPosition,
+		})))
+	}
+	p.Messages.Append(s)
+}
+
+// targetMessage adds a system message of type err for the hyperlink target at line and startPosition to the parser
+// messages. args are substituted into the message.
+func (p *Parser) targetMessage(err mes.MessageType, line, startPosition int, args ...interface{}) {
+	nm := mes.NewParserMessage(err)
+	nm.Args = args
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = line, line, line, startPosition
+	p.Msgr("Generating target system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	p.Messages.Append(s)
+}
+
+// roleMessage adds a system message of type err for the interpreted text n to the parser messages. args are substituted
+// into the message.
+func (p *Parser) roleMessage(err mes.MessageType, n *doc.InlineInterpretedText, args ...interface{}) {
+	nm := mes.NewParserMessage(err)
+	nm.Args = args
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = n.Line, n.Line, n.Line, n.StartPosition
+	p.Msgr("Generating role system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	p.Messages.Append(s)
+}
+
+// listMessage adds a system message of type err for the list at line and startPosition to the parser messages. args are
+// substituted into the message.
+func (p *Parser) listMessage(err mes.MessageType, line, startPosition int, args ...interface{}) {
+	nm := mes.NewParserMessage(err)
+	nm.Args = args
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = line, line, line, startPosition
+	p.Msgr("Generating list system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	p.Messages.Append(s)
+}
+
+// blockQuoteMessage adds a system message of type err for the block quote at line and startPosition to the parser messages.
+func (p *Parser) blockQuoteMessage(err mes.MessageType, line, startPosition int) {
+	nm := mes.NewParserMessage(err)
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = line, line, line, startPosition
+	p.Msgr("Generating block quote system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	p.Messages.Append(s)
+}
+
+// literalBlockMessage adds a system message of type err for the literal block at line and startPosition to the parser
+// messages.
+func (p *Parser) literalBlockMessage(err mes.MessageType, line, startPosition int) {
+	nm := mes.NewParserMessage(err)
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = line, line, line, startPosition
+	p.Msgr("Generating literal block system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	p.Messages.Append(s)
+}
+
+// transitionMessage adds a system message of type err for the transition at line and startPosition to the parser messages.
+// If text is not empty, it is added to the message as a literal block.
+func (p *Parser) transitionMessage(err mes.MessageType, line, startPosition int, text string) {
+	nm := mes.NewParserMessage(err)
+	nm.LiteralText = text
+	nm.MessageLine, nm.StartLine, nm.EndLine, nm.StartPosition = line, line, line, startPosition
+	p.Msgr("Generating transition system message", "type", err.String())
+
+	s := doc.NewSystemMessage(nm, nm.MessageLine)
+	s.StartPosition = nm.StartPosition
+	s.StartLine = nm.StartLine
+	s.EndLine = nm.EndLine
+	if len(nm.LiteralText) > 0 {
+		s.Append(doc.NewLiteralBlock(p.lex.Locate(&tok.Item{
+			Text:          nm.LiteralText,
+			Length:        len(nm.LiteralText),
+			Line:          nm.StartLine,
+			StartPosition: nm.StartPosition,
+		})))
+	}
+	p.Messages.Append(s)
+}
@@ -0,0 +1,361 @@
+package parser
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+)
+
+func init() {
+	registerDirective("list-table", &directive{
+		optionalArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec: map[string]optionFunc{
+			"header-rows":  nonNegativeIntOption,
+			"stub-columns": nonNegativeIntOption,
+			"width":        lengthOrPercentageOrUnitlessOption,
+			"widths":       widthsOption,
+			"class":        classOption,
+			"name":         unchangedOption,
+			"align":        choiceOption("left", "center", "right"),
+		},
+		hasContent: true,
+		run:        listTableDirective,
+	})
+	registerDirective("csv-table", &directive{
+		optionalArguments:       1,
+		finalArgumentWhitespace: true,
+		optionSpec: map[string]optionFunc{
+			"header-rows":  nonNegativeIntOption,
+			"stub-columns": nonNegativeIntOption,
+			"header":       unchangedOption,
+			"width":        lengthOrPercentageOrUnitlessOption,
+			"widths":       widthsOption,
+			"file":         unchangedRequiredOption,
+			"encoding":     encodingOption,
+			"delim":        delimOption,
+			"keepspace":    flagOption,
+			"class":        classOption,
+			"name":         unchangedOption,
+			"align":        choiceOption("left", "center", "right"),
+		},
+		hasContent: true,
+		run:        csvTableDirective,
+	})
+}
+
+// widthsOption validates the widths option of tables, which is "auto", "grid", or a list of positive integers separated by
+// commas or spaces. The integers are returned separated by a single space.
+func widthsOption(value string) (string, error) {
+	v := strings.ToLower(strings.TrimSpace(value))
+	if v == "auto" || v == "grid" {
+		return v, nil
+	}
+	fields := strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(fields) == 0 {
+		return "", errors.New("argument required but none supplied")
+	}
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return "", fmt.Errorf("invalid literal for int(): %q", f)
+		}
+		if n <= 0 {
+			return "", errors.New("negative or zero value; must be positive")
+		}
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// delimOption validates the delimiter of CSV data. The value is a single character, "tab", or "space".
+func delimOption(value string) (string, error) {
+	switch v := strings.TrimSpace(value); {
+	case v == "tab":
+		return "\t", nil
+	case v == "space":
+		return " ", nil
+	case utf8.RuneCountInString(v) == 1:
+		return v, nil
+	}
+	return "", fmt.Errorf("%q invalid; must be a single character or \"tab\" or \"space\"", value)
+}
+
+// tableCell contains the text of a table cell and the position of the text in the document.
+type tableCell struct {
+	lines  []string
+	line   int
+	indent int // The number of columns before the text of the cell
+}
+
+// newTable creates a TableNode from the rows of cells. The cell text is parsed as body elements. The first header-rows
+// rows of rows are added to the table head, the header rows are added to the table head before them. An error is returned
+// if the dimensions of the table do not match the header-rows, stub-columns, or widths options.
+func (p *Parser) newTable(d *directiveBlock, header, rows [][]tableCell) (*doc.TableNode, error) {
+	cols := 0
+	for _, r := range append(append([][]tableCell{}, header...), rows...) {
+		if len(r) > cols {
+			cols = len(r)
+		}
+	}
+
+	t := doc.NewTable(p.directiveItem(d))
+	t.Classes = d.classes()
+	t.Names = d.names()
+	t.Align = d.options["align"]
+	t.Width = d.options["width"]
+	if len(d.arguments) > 0 {
+		t.Title = doc.NewTitleNode()
+		t.Title.Line = d.line
+		t.Title.StartPosition = d.argPosition
+		t.Title.NodeList = p.parseInline(d.arguments[0], d.line, d.argPosition)
+		t.Title.Length = utf8.RuneCountInString(d.arguments[0])
+	}
+	switch widths := d.options["widths"]; widths {
+	case "", "grid":
+	case "auto":
+		t.Classes = append(t.Classes, "colwidths-auto")
+	default:
+		for _, w := range strings.Fields(widths) {
+			n, _ := strconv.Atoi(w)
+			t.ColumnWidths = append(t.ColumnWidths, n)
+		}
+		if len(t.ColumnWidths) != cols {
+			return nil, newDirectiveError(mes.DirectiveErrorTableWidths, d.name, cols)
+		}
+		t.Classes = append(t.Classes, "colwidths-given")
+	}
+
+	headerRows := 0
+	if d.hasOption("header-rows") {
+		headerRows, _ = strconv.Atoi(d.options["header-rows"])
+	}
+	if len(rows) < headerRows {
+		return nil, newDirectiveError(mes.DirectiveErrorTableHeaderRows, headerRows, len(rows), d.name)
+	} else if len(rows) == headerRows && headerRows > 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorTableNoBody, len(rows), d.name)
+	}
+	if d.hasOption("stub-columns") {
+		t.StubColumns, _ = strconv.Atoi(d.options["stub-columns"])
+		if t.StubColumns >= cols {
+			return nil, newDirectiveError(mes.DirectiveErrorTableStubColumns, t.StubColumns, cols, d.name)
+		}
+	}
+
+	header = append(header, rows[:headerRows]...)
+	if len(header) > 0 {
+		t.Append(doc.NewTableHead(p.tableRows(header)...))
+	}
+	t.Append(doc.NewTableBody(p.tableRows(rows[headerRows:])...))
+	return t, nil
+}
+
+// tableRows parses the text of the cells and returns the table rows.
+func (p *Parser) tableRows(rows [][]tableCell) []*doc.TableRowNode {
+	var nodes []*doc.TableRowNode
+	for _, r := range rows {
+		row := doc.NewTableRow()
+		for _, c := range r {
+			row.Append(doc.NewTableEntry(p.subParse(c.lines, c.line, c.indent)))
+		}
+		nodes = append(nodes, row)
+	}
+	return nodes
+}
+
+var bulletMarker = regexp.MustCompile(`^([-*+•‣⁃])( +|$)`)
+
+// listItem is an item of a bullet list split by splitBulletList.
+type listItem struct {
+	lines  []string
+	line   int // The index of the first line of the item
+	indent int // The number of columns before the text of the item
+}
+
+// splitBulletList splits lines into the items of a single bullet list. The text of each item is unindented. An error is
+// returned if lines contain anything besides one bullet list.
+func splitBulletList(lines []string) ([]listItem, error) {
+	var items []listItem
+	var bullet string
+	for i, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			if len(items) > 0 {
+				items[len(items)-1].lines = append(items[len(items)-1].lines, "")
+			}
+			continue
+		}
+		if !strings.HasPrefix(l, " ") {
+			m := bulletMarker.FindStringSubmatch(l)
+			if m == nil || (bullet != "" && m[1] != bullet) {
+				return nil, errors.New("exactly one bullet list expected")
+			}
+			bullet = m[1]
+			items = append(items, listItem{line: i, indent: len(m[0])})
+			if text := l[len(m[0]):]; text != "" {
+				items[len(items)-1].lines = []string{text}
+			}
+			continue
+		}
+		if len(items) == 0 {
+			return nil, errors.New("exactly one bullet list expected")
+		}
+		it := &items[len(items)-1]
+		if len(it.lines) == 0 {
+			it.line = i
+			it.indent = len(l) - len(strings.TrimLeft(l, " "))
+		}
+		n := len(l) - len(strings.TrimLeft(l, " "))
+		if n > it.indent {
+			n = it.indent
+		}
+		it.lines = append(it.lines, l[n:])
+	}
+	for i := range items {
+		for len(items[i].lines) > 0 && items[i].lines[len(items[i].lines)-1] == "" {
+			items[i].lines = items[i].lines[:len(items[i].lines)-1]
+		}
+	}
+	if len(items) == 0 {
+		return nil, errors.New("exactly one bullet list expected")
+	}
+	return items, nil
+}
+
+// listTableDirective creates a TableNode from a two-level bullet list. Each item of the first level list is a row of the
+// table, the items of the second level lists are the cells of the row. All rows must have the same number of cells.
+func listTableDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+	items, err := splitBulletList(d.content)
+	if err != nil {
+		return nil, newDirectiveError(mes.DirectiveErrorTableContent, d.name, err.Error())
+	}
+	base := d.startPosition - 1 + d.indent
+	var rows [][]tableCell
+	for i, it := range items {
+		cells, err := splitBulletList(it.lines)
+		if err != nil {
+			return nil, newDirectiveError(mes.DirectiveErrorTableContent, d.name, fmt.Sprintf(
+				"two-level bullet list expected, but row %d does not contain a second-level bullet list", i+1))
+		}
+		if i > 0 && len(cells) != len(rows[0]) {
+			return nil, newDirectiveError(mes.DirectiveErrorTableContent, d.name, fmt.Sprintf(
+				"uniform two-level bullet list expected, but row %d does not contain the same number of items as "+
+					"row 1 (%d vs %d)", i+1, len(cells), len(rows[0])))
+		}
+		var row []tableCell
+		for _, c := range cells {
+			row = append(row, tableCell{
+				lines:  c.lines,
+				line:   d.contentLine + it.line + c.line,
+				indent: base + it.indent + c.indent,
+			})
+		}
+		rows = append(rows, row)
+	}
+	t, err := p.newTable(d, nil, rows)
+	if err != nil {
+		return nil, err
+	}
+	return doc.NodeList{t}, nil
+}
+
+// csvTableDirective creates a TableNode from CSV data given as the content of the directive or read from the file given
+// by the file option. The header option contains additional CSV data for the table head. Rows with fewer cells than the
+// longest row are padded with empty cells and a warning is generated.
+func csvTableDirective(p *Parser, d *directiveBlock) (doc.NodeList, error) {
+	text := strings.Join(d.content, "\n")
+	line, indent := d.contentLine, d.startPosition-1+d.indent
+	if d.hasOption("file") {
+		if len(d.content) > 0 {
+			return nil, newDirectiveError(mes.DirectiveErrorFileAndContent, d.name)
+		}
+		if p.Config.FS == nil {
+			return nil, newDirectiveError(mes.DirectiveWarningDirectiveDisabled, d.name)
+		}
+		name, err := p.includePath(d.options["file"])
+		if err != nil {
+			return nil, newDirectiveError(mes.DirectiveSeverePath, d.name, err.Error())
+		}
+		if text, err = p.readFile(d, name); err != nil {
+			return nil, err
+		}
+		line, indent = d.line, 0
+	} else if len(d.content) == 0 {
+		return nil, newDirectiveError(mes.DirectiveErrorContentBlockExpected, d.name)
+	}
+
+	var header [][]tableCell
+	if h, ok := d.options["header"]; ok {
+		var err error
+		if header, err = readCSV(d, h, d.line, 0); err != nil {
+			return nil, err
+		}
+	}
+	rows, err := readCSV(d, text, line, indent)
+	if err != nil {
+		return nil, err
+	}
+
+	cols := 0
+	for _, r := range append(append([][]tableCell{}, header...), rows...) {
+		if len(r) > cols {
+			cols = len(r)
+		}
+	}
+	pad := func(rows [][]tableCell, offset int) {
+		for i, r := range rows {
+			if len(r) == cols {
+				continue
+			}
+			p.directiveMessage(mes.DirectiveWarningTableRaggedRow, d, offset+i+1, d.name, len(r), cols)
+			for len(rows[i]) < cols {
+				rows[i] = append(rows[i], tableCell{})
+			}
+		}
+	}
+	pad(header, 0)
+	pad(rows, len(header))
+
+	t, err := p.newTable(d, header, rows)
+	if err != nil {
+		return nil, err
+	}
+	return doc.NodeList{t}, nil
+}
+
+// readCSV reads the rows of the CSV data in text using the delimiter of the directive. line and indent are the position
+// of text in the document.
+func readCSV(d *directiveBlock, text string, line, indent int) ([][]tableCell, error) {
+	r := csv.NewReader(strings.NewReader(text))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = !d.hasOption("keepspace")
+	r.LazyQuotes = true
+	if delim, ok := d.options["delim"]; ok {
+		r.Comma, _ = utf8.DecodeRuneInString(delim)
+	}
+	var rows [][]tableCell
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, newDirectiveError(mes.DirectiveErrorTableCSV, d.name, err.Error())
+		}
+		var row []tableCell
+		for i, f := range rec {
+			l, c := r.FieldPos(i)
+			row = append(row, tableCell{lines: strings.Split(f, "\n"), line: line + l - 1, indent: indent + c - 1})
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,38 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBulletList(t *testing.T) {
+	items, err := splitBulletList([]string{"* - a", "  - b", "", "    more", "*", "  - c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []listItem{
+		{lines: []string{"- a", "- b", "", "  more"}, line: 0, indent: 2},
+		{lines: []string{"- c"}, line: 5, indent: 2},
+	}
+	if !reflect.DeepEqual(items, expect) {
+		t.Errorf("got %#v, expect %#v", items, expect)
+	}
+	for _, lines := range [][]string{{"text"}, {"* a", "- b"}, {"  * a"}, {""}} {
+		if _, err := splitBulletList(lines); err == nil {
+			t.Errorf("%q: expect error", lines)
+		}
+	}
+}
+
+func TestWidthsOption(t *testing.T) {
+	tests := []struct{ value, expect string }{
+		{"auto", "auto"}, {" Grid ", "grid"}, {"1, 2,3", "1 2 3"}, {"10 20", "10 20"},
+		{"0 1", ""}, {"a", ""}, {"", ""},
+	}
+	for _, tt := range tests {
+		got, err := widthsOption(tt.value)
+		if got != tt.expect || (err != nil) != (tt.expect == "") {
+			t.Errorf("widthsOption(%q) = %q, %v; expect %q", tt.value, got, err, tt.expect)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// isTargetToken returns true if t is part of an explicit hyperlink target following the HyperlinkTargetStart token.
+func isTargetToken(t *tok.Item) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Type {
+	case tok.HyperlinkTargetPrefix, tok.HyperlinkTargetQuote, tok.HyperlinkTargetName, tok.HyperlinkTargetSuffix,
+		tok.HyperlinkTargetURI, tok.InlineReferenceOpen, tok.InlineReferenceText, tok.InlineReferenceClose:
+		return true
+	}
+	return false
+}
+
+// hyperlinkTarget parses an explicit hyperlink target beginning at the HyperlinkTargetStart token i. The target is a named
+// target if it has a name, otherwise it is anonymous. A target with a link block ending in an underscore is an indirect
+// target referring to another target by name, any other link block is the URI of an external target.
+func (p *Parser) hyperlinkTarget(i *tok.Item) {
+	t := doc.NewHyperlinkTarget(i)
+	t.Anonymous = i.Text == "__"
+	var name, uri, ref []string
+	for {
+		ni := p.peek(1)
+		if ni != nil && ni.Type == tok.Space && isTargetToken(p.peek(2)) {
+			p.next(1)
+			continue
+		}
+		if !isTargetToken(ni) {
+			break
+		}
+		p.next(1)
+		switch ni.Type {
+		case tok.HyperlinkTargetPrefix:
+			t.Anonymous = t.Anonymous || ni.Text == "__"
+		case tok.HyperlinkTargetName:
+			name = append(name, ni.Text)
+		case tok.HyperlinkTargetURI:
+			uri = append(uri, ni.Text)
+		case tok.InlineReferenceText:
+			ref = append(ref, ni.Text)
+		}
+	}
+
+	if len(ref) > 0 {
+		t.RefName = doc.NormalizeReferenceName(strings.Join(ref, " "))
+	} else if len(uri) > 0 {
+		t.RefURI = strings.Join(strings.Fields(unescapeTarget(strings.Join(uri, " "))), "")
+	}
+	if !t.Anonymous {
+		if n := doc.NormalizeReferenceName(unescapeTarget(strings.Join(name, " "))); n != "" {
+			t.Names = []string{n}
+		}
+	}
+
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	p.nodeTarget.Append(t)
+}
+
+// unescapeTarget removes the backslash escapes from the name or link block of a hyperlink target. Escaped whitespace is
+// removed.
+func unescapeTarget(s string) string {
+	var b strings.Builder
+	r := []rune(s)
+	for x := 0; x < len(r); x++ {
+		if r[x] == '\\' && x+1 < len(r) {
+			x++
+			if unicode.IsSpace(r[x]) {
+				continue
+			}
+		}
+		b.WriteRune(r[x])
+	}
+	return b.String()
+}
+
+// targets records the hyperlink target names of the document. The rules for duplicate names are those of docutils.
+type targets struct {
+	ids      map[string]doc.Node // The element with each id
+	nameIDs  map[string]string   // The id of the element with each name, empty if the name is a duplicate
+	explicit map[string]bool     // True if the name was used by an explicit target
+}
+
+// registerTargets assigns ids to the sections and explicit hyperlink targets of the document in document order and records
+// their names. Section titles and the table of contents are implicit targets, elements named with the name option of a
+// directive are explicit targets. An explicit target takes priority over an
+// implicit target with the same name. If two targets of the same kind have the same name, neither can be referenced by that
+// name and the name is moved to the DupNames of the elements. An info message is generated for duplicate implicit targets
+// and a warning for duplicate explicit targets.
+func (p *Parser) registerTargets() {
+	t := &targets{ids: make(map[string]doc.Node), nameIDs: make(map[string]string), explicit: make(map[string]bool)}
+	p.Nodes.Walk(func(n doc.Node) bool {
+		switch e := n.(type) {
+		case *doc.SectionNode:
+			if e.Title != nil && len(e.IDs) == 0 {
+				e.Names = []string{doc.NormalizeReferenceName(e.Title.NodeList.Text())}
+				e.IDs = []string{p.newID(targetID(e.Names), "section")}
+				p.registerNames(t, e, e.IDs[0], &e.Names, &e.DupNames, false, e.Title.Line, e.Title.StartPosition)
+			}
+		case *doc.TopicNode:
+			if len(e.Names) > 0 && len(e.IDs) == 0 {
+				e.IDs = []string{p.newID(targetID(e.Names), "topic")}
+				p.registerNames(t, e, e.IDs[0], &e.Names, &e.DupNames, false, e.Line, e.StartPosition)
+			}
+		case *doc.HyperlinkTargetNode:
+			if len(e.IDs) == 0 {
+				e.IDs = []string{p.newID(targetID(e.Names), "target")}
+				p.registerNames(t, e, e.IDs[0], &e.Names, &e.DupNames, true, e.Line, e.StartPosition)
+			}
+		case *doc.LiteralBlockNode:
+			p.addName(t, e, &e.IDs, &e.Names, &e.DupNames, e.Line, e.StartPosition)
+		case *doc.AdmonitionNode:
+			p.addName(t, e, &e.IDs, &e.Names, &e.DupNames, e.Line, e.StartPosition)
+		case *doc.ImageNode:
+			p.addName(t, e, &e.IDs, &e.Names, &e.DupNames, e.Line, e.StartPosition)
+		case *doc.FigureNode:
+			// The name option of a figure names the image
+			p.addName(t, e.Image, &e.Image.IDs, &e.Image.Names, &e.Image.DupNames, e.Image.Line, e.Image.StartPosition)
+		case *doc.MathBlockNode:
+			p.addName(t, e, &e.IDs, &e.Names, &e.DupNames, e.Line, e.StartPosition)
+		case *doc.TableNode:
+			p.addName(t, e, &e.IDs, &e.Names, &e.DupNames, e.Line, e.StartPosition)
+		}
+		return true
+	})
+}
+
+// addName assigns an id to the element n named with the name option of a directive and records its names as explicit
+// targets. Elements without names are not targets. This is docutils' Directive.add_name.
+func (p *Parser) addName(t *targets, n doc.Node, ids, names, dupNames *[]string, line, startPosition int) {
+	if len(*names) == 0 || len(*ids) > 0 {
+		return
+	}
+	*ids = []string{p.newID(targetID(*names), "target")}
+	p.registerNames(t, n, (*ids)[0], names, dupNames, true, line, startPosition)
+}
+
+// targetID returns the id made from the first name in names. An empty string is returned if there are no names.
+func targetID(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return doc.MakeID(names[0])
+}
+
+// registerNames records the names of the element n with the id, and adds them to the namespace of the document. Duplicate
+// names are moved from names to dupNames and a system message is generated at line and startPosition. This is a port of
+// docutils' document.set_name_id_map.
+func (p *Parser) registerNames(t *targets, n doc.Node, id string, names, dupNames *[]string, explicit bool, line,
+	startPosition int) {
+	t.ids[id] = n
+	for _, name := range append([]string{}, *names...) {
+		p.Document.Namespace.Add(&doc.Name{Name: name, Kind: doc.NameTarget, Explicit: explicit, ID: id, Node: n, Line: line,
+			StartPosition: startPosition})
+		oldID, ok := t.nameIDs[name]
+		if !ok {
+			t.nameIDs[name] = id
+			t.explicit[name] = explicit
+			continue
+		}
+		oldExplicit := t.explicit[name]
+		t.explicit[name] = oldExplicit || explicit
+		msg := mes.ReferenceInfoDuplicateImplicitTarget
+		if explicit {
+			if oldExplicit {
+				msg = mes.ReferenceWarningDuplicateExplicitTarget
+				if oldID != "" {
+					old := t.ids[oldID]
+					if sameRefURI(old, n) {
+						msg = mes.ReferenceInfoDuplicateExplicitTarget
+					} else {
+						dupName(old, name)
+						t.nameIDs[name] = ""
+					}
+				}
+				dupName(n, name)
+			} else {
+				t.nameIDs[name] = id
+				if oldID != "" {
+					dupName(t.ids[oldID], name)
+				}
+				if oldID == "" {
+					// The implicit targets were already duplicates, no message is generated
+					continue
+				}
+			}
+		} else {
+			if oldID != "" && !oldExplicit {
+				t.nameIDs[name] = ""
+				dupName(t.ids[oldID], name)
+			}
+			dupName(n, name)
+		}
+		p.targetMessage(msg, line, startPosition, name)
+	}
+}
+
+// sameRefURI returns true if the elements a and b are external targets with the same URI.
+func sameRefURI(a, b doc.Node) bool {
+	at, ok := a.(*doc.HyperlinkTargetNode)
+	bt, ok2 := b.(*doc.HyperlinkTargetNode)
+	return ok && ok2 && len(at.Names) > 0 && at.RefURI != "" && at.RefURI == bt.RefURI
+}
+
+// dupName moves name from the names of the element n to its duplicate names.
+func dupName(n doc.Node, name string) {
+	var names, dupNames *[]string
+	switch e := n.(type) {
+	case *doc.SectionNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.TopicNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.HyperlinkTargetNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.LiteralBlockNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.AdmonitionNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.ImageNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.MathBlockNode:
+		names, dupNames = &e.Names, &e.DupNames
+	case *doc.TableNode:
+		names, dupNames = &e.Names, &e.DupNames
+	default:
+		return
+	}
+	*dupNames = append(*dupNames, name)
+	for x, nm := range *names {
+		if nm == name {
+			*names = append((*names)[:x], (*names)[x+1:]...)
+			break
+		}
+	}
+	if len(*names) == 0 {
+		*names = nil
+	}
+}
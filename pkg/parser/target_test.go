@@ -0,0 +1,37 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+func TestDocumentNamespace(t *testing.T) {
+	input := "A Title\n=======\n\n.. _a title: http://example.com\n\n.. _Other-Target:\n\nParagraph.\n\n" +
+		".. image:: a.png\n   :name: An Image\n\n.. note::\n   :name: a title\n\n   Text.\n"
+	p, err := NewParser("test", input, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Parse()
+	ns := p.Document.Namespace
+	names := ns.Names()
+	expect := []struct {
+		name     string
+		explicit bool
+	}{
+		{"a title", false}, {"a title", true}, {"other-target", true}, {"an image", true}, {"a title", true},
+	}
+	if len(names) != len(expect) {
+		t.Fatalf("got %d names, expect %d", len(names), len(expect))
+	}
+	for i, n := range names {
+		if n.Name != expect[i].name || n.Explicit != expect[i].explicit || n.ID == "" || n.Node == nil {
+			t.Errorf("got name %q explicit %t id %q, expect %q explicit %t", n.Name, n.Explicit, n.ID, expect[i].name,
+				expect[i].explicit)
+		}
+	}
+	if d := ns.Duplicates(); len(d) != 1 || d[0][0].Name != "a title" {
+		t.Errorf("got duplicates %v, expect \"a title\"", d)
+	}
+}
@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"sort"
+	"strconv"
+)
+
+// transform is a change to the document tree that is applied after the whole document is parsed. Transforms are applied
+// in order of priority, lowest first. Transforms added with the same priority are applied in the order they were added.
+// The priorities match the default priorities of the docutils transforms.
+type transform struct {
+	priority int
+	apply    func(p *Parser)
+}
+
+const (
+	// transformPriorityClass is the priority of the transform adding the classes of class directives to the following
+	// elements.
+	transformPriorityClass = 210
+
+	// transformPriorityDocTitle is the priority of the transform promoting section titles to the document title and
+	// subtitle.
+	transformPriorityDocTitle = 320
+
+	// transformPrioritySectNum is the priority of the section numbering transform. Section numbers are added before the
+	// table of contents is built so the numbers appear in the entries.
+	transformPrioritySectNum = 710
+
+	// transformPriorityContents is the priority of the table of contents transform.
+	transformPriorityContents = 720
+
+	// transformPriorityTransitions is the priority of the transform checking the placement of transitions.
+	transformPriorityTransitions = 830
+)
+
+// addTransform adds a transform to be applied when parsing is complete.
+func (p *Parser) addTransform(priority int, apply func(p *Parser)) {
+	p.transforms = append(p.transforms, transform{priority: priority, apply: apply})
+}
+
+// applyTransforms applies the pending transforms to the document. Parsers used for nested content do not apply
+// transforms, their transforms are applied by the parser of the document.
+func (p *Parser) applyTransforms() {
+	if p.subParser {
+		return
+	}
+	sort.SliceStable(p.transforms, func(i, j int) bool { return p.transforms[i].priority < p.transforms[j].priority })
+	for _, t := range p.transforms {
+		t.apply(p)
+	}
+	p.transforms = nil
+}
+
+// newID returns an id unique in the document. If base is not empty and unused, it is returned. Otherwise a number is
+// appended to base, or to tag if base is empty, i.e., "section-1". This is the id generation of docutils.
+func (p *Parser) newID(base, tag string) string {
+	if p.ids == nil {
+		p.ids = make(map[string]bool)
+		p.idCounters = make(map[string]int)
+	}
+	id := base
+	if id == "" || p.ids[id] {
+		prefix := tag
+		if base != "" {
+			prefix = base
+		}
+		for id == "" || p.ids[id] {
+			p.idCounters[prefix]++
+			id = prefix + "-" + strconv.Itoa(p.idCounters[prefix])
+		}
+	}
+	p.ids[id] = true
+	return id
+}
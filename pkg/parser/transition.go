@@ -0,0 +1,89 @@
+package parser
+
+import (
+	doc "github.com/demizer/go-rst/pkg/document"
+	mes "github.com/demizer/go-rst/pkg/messages"
+	tok "github.com/demizer/go-rst/pkg/token"
+)
+
+// transition appends the transition marker i to the document. Transitions are only allowed in the document and in
+// sections, a transition marker in the content of another body element is reported as an error and discarded.
+func (p *Parser) transition(i *tok.Item) {
+	if p.nested {
+		p.transitionMessage(mes.TransitionSevereUnexpected, i.Line, i.StartPosition, i.Text)
+		return
+	}
+	if p.nodeTarget.IsParagraphNode() {
+		if p.sectionLevels.lastSectionNode != nil {
+			p.nodeTarget.SetParent(p.sectionLevels.lastSectionNode)
+		} else {
+			p.nodeTarget.Reset()
+		}
+	}
+	p.nodeTarget.Append(doc.NewTransition(i))
+}
+
+// transitionPlace is the location of a transition in the document tree. lists are the node lists containing the
+// transition, from the document node list to the node list of the parent of the transition. sections[i] is the section in
+// lists[i] that owns lists[i+1].
+type transitionPlace struct {
+	transition *doc.TransitionNode
+	lists      []*doc.NodeList
+	sections   []doc.Node
+}
+
+// transitions is the transition placement transform. Transitions may not begin a section or the document, and may not be
+// adjacent to another transition. A transition at the end of a section is moved after the section, or after the closest
+// enclosing section that is not the last element of its parent. A transition at the end of the document is an error. This
+// is the Transitions transform of docutils.
+func transitions(p *Parser) {
+	var places []transitionPlace
+	var find func(nl *doc.NodeList, lists []*doc.NodeList, sections []doc.Node)
+	find = func(nl *doc.NodeList, lists []*doc.NodeList, sections []doc.Node) {
+		lists = append(lists[:len(lists):len(lists)], nl)
+		for _, n := range *nl {
+			switch t := n.(type) {
+			case *doc.TransitionNode:
+				places = append(places, transitionPlace{transition: t, lists: lists, sections: sections})
+			case *doc.SectionNode:
+				find(&t.NodeList, lists, append(sections[:len(sections):len(sections)], t))
+			}
+		}
+	}
+	find(&p.Document.NodeList, nil, nil)
+
+	for _, t := range places {
+		nl := t.lists[len(t.lists)-1]
+		index := nodeIndex(*nl, t.transition)
+		if index == 0 {
+			p.transitionMessage(mes.TransitionErrorBegin, t.transition.Line, t.transition.StartPosition, "")
+		} else if _, ok := (*nl)[index-1].(*doc.TransitionNode); ok {
+			p.transitionMessage(mes.TransitionErrorAdjacent, t.transition.Line, t.transition.StartPosition, "")
+		}
+		if index != len(*nl)-1 {
+			continue
+		}
+		level := len(t.sections)
+		for level > 0 && nodeIndex(*t.lists[level-1], t.sections[level-1]) == len(*t.lists[level-1])-1 {
+			level--
+		}
+		if level == 0 {
+			p.transitionMessage(mes.TransitionErrorEndDocument, t.transition.Line, t.transition.StartPosition, "")
+			continue
+		}
+		nl.Remove(t.transition)
+		parent := t.lists[level-1]
+		index = nodeIndex(*parent, t.sections[level-1]) + 1
+		*parent = append((*parent)[:index], append(doc.NodeList{t.transition}, (*parent)[index:]...)...)
+	}
+}
+
+// nodeIndex returns the index of n in nl, or -1 if nl does not contain n.
+func nodeIndex(nl doc.NodeList, n doc.Node) int {
+	for i, v := range nl {
+		if v == n {
+			return i
+		}
+	}
+	return -1
+}
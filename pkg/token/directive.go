@@ -0,0 +1,120 @@
+package token
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// directiveStart matches the explicit markup start, the directive type, and the directive type suffix. The directive type is
+// a simple reference name that may not begin with an underscore.
+var directiveStart = regexp.MustCompile(`^\.\.[ ]+([\pL\pN]+(?:[-._+:][\pL\pN]+)*)[ ]?::(?:[ ]|$)`)
+
+// isDirective returns true if the lexer is positioned at the explicit markup start of a directive, i.e., ".. note::".
+func isDirective(l *Lexer) bool {
+	if l.lastItem != nil && l.lastItem.Type == Title {
+		return false
+	}
+	if l.mark != '.' || l.peek(1) != '.' {
+		return false
+	}
+	if directiveStart.MatchString(l.currentLine()[l.index:]) {
+		l.Msg("Found directive!")
+		return true
+	}
+	l.Msg("Directive not found")
+	return false
+}
+
+// lineIndent returns the number of leading whitespace bytes of line.
+func lineIndent(line string) int {
+	return len(line) - len(strings.TrimLeftFunc(line, unicode.IsSpace))
+}
+
+// directiveBlockEnd returns the index of the last line in l.lines that is part of the indented directive block starting on
+// the line after the current line. A line is part of the block if it is indented further than column. Trailing blank lines
+// are not part of the block. If there is no block, the current line index is returned.
+func directiveBlockEnd(l *Lexer, column int) int {
+	last := l.line
+	for i := l.line + 1; i < len(l.lines); i++ {
+		if strings.TrimSpace(l.lines[i]) == "" {
+			continue
+		}
+		if lineIndent(l.lines[i]) <= column {
+			break
+		}
+		last = i
+	}
+	return last
+}
+
+// lexDirective emits the tokens of a directive. The first line is emitted as a DirectiveMark, DirectiveType,
+// DirectiveTypeSuffix, and an optional DirectiveArgument. Each line of the indented directive block is emitted as a Space
+// containing the common indentation of the block followed by a DirectiveBlock containing the rest of the line. Blank lines
+// inside the block are emitted as BlankLine.
+func lexDirective(l *Lexer) stateFn {
+	column := l.index
+	name := directiveStart.FindStringSubmatch(l.currentLine()[l.index:])[1]
+
+	l.next()
+	l.next()
+	l.emit(DirectiveMark)
+	lexSpace(l)
+
+	for range name {
+		l.next()
+	}
+	l.emit(DirectiveType)
+	if l.mark == ' ' {
+		lexSpace(l)
+	}
+	l.next()
+	l.next()
+	l.emit(DirectiveTypeSuffix)
+
+	if !l.isEndOfLine() {
+		lexSpace(l)
+	}
+	if !l.isEndOfLine() {
+		l.index = len(l.currentLine())
+		l.mark, l.width = EOL, 0
+		l.emit(DirectiveArgument)
+	}
+
+	last := directiveBlockEnd(l, column)
+	if last == l.line {
+		if !l.isLastLine() {
+			l.nextLine()
+		}
+		return lexStart
+	}
+
+	indent := -1
+	for i := l.line + 1; i <= last; i++ {
+		if strings.TrimSpace(l.lines[i]) == "" {
+			continue
+		}
+		if n := lineIndent(l.lines[i]); indent == -1 || n < indent {
+			indent = n
+		}
+	}
+
+	for i := l.line + 1; i <= last; i++ {
+		l.line, l.start, l.index = i, 0, 0
+		if strings.TrimSpace(l.lines[i]) == "" {
+			l.index = len(l.lines[i])
+			l.emit(BlankLine)
+			continue
+		}
+		l.index = indent
+		l.emit(Space)
+		l.index = len(l.lines[i])
+		l.emit(DirectiveBlock)
+	}
+	l.mark, l.width = EOL, 0
+
+	if !l.isLastLine() {
+		l.nextLine()
+	}
+	return lexStart
+}
@@ -2,9 +2,13 @@ package token
 
 import (
 	"fmt"
+	"regexp"
 	"unicode"
 )
 
+// rolePrefix matches an interpreted text role that comes before the interpreted text, i.e., ":code:`text`".
+var rolePrefix = regexp.MustCompile("^:[\\pL\\pN]+(?:[-._+][\\pL\\pN]+)*:`[^`\\s]")
+
 func isInlineMarkup(l *Lexer) bool {
 	isOpenerRune := func(r rune) bool {
 		for _, x := range inlineMarkupStartStringOpeners {
@@ -68,6 +72,29 @@ func isInlineMarkup(l *Lexer) bool {
 	return false
 }
 
+// isInlineRolePrefix returns true if the lexer is positioned at the start of an interpreted text role that precedes the
+// interpreted text. The role must be at the start of the line or follow whitespace or an opening character.
+func isInlineRolePrefix(l *Lexer) bool {
+	if l.mark != ':' {
+		return false
+	}
+	if l.index > 0 {
+		b := l.peekBack(1)
+		opener := unicode.IsSpace(b) || unicode.In(b, unicode.Pd, unicode.Ps, unicode.Pi, unicode.Pf)
+		for _, x := range inlineMarkupStartStringOpeners {
+			opener = opener || x == b
+		}
+		if !opener {
+			return false
+		}
+	}
+	if rolePrefix.MatchString(l.currentLine()[l.index:]) {
+		l.Msg("Found interpreted text role prefix")
+		return true
+	}
+	return false
+}
+
 func isInlineMarkupClosed(l *Lexer, markup string) bool {
 	isEndASCII := func(r rune) bool {
 		for _, x := range inlineMarkupEndStringClosers {
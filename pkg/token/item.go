@@ -23,6 +23,9 @@ const (
 	CommentMark
 	EnumListAffix
 	EnumListArabic
+	EnumListAlpha
+	EnumListRoman
+	EnumListAuto
 	HyperlinkTargetStart
 	HyperlinkTargetPrefix
 	HyperlinkTargetQuote
@@ -51,6 +54,11 @@ const (
 	DefinitionText
 	Bullet
 	Escape
+	DirectiveMark
+	DirectiveType
+	DirectiveTypeSuffix
+	DirectiveArgument
+	DirectiveBlock
 )
 
 var elements = [...]string{
@@ -68,6 +76,9 @@ var elements = [...]string{
 	"CommentMark",
 	"EnumListAffix",
 	"EnumListArabic",
+	"EnumListAlpha",
+	"EnumListRoman",
+	"EnumListAuto",
 	"HyperlinkTargetStart",
 	"HyperlinkTargetPrefix",
 	"HyperlinkTargetQuote",
@@ -96,6 +107,11 @@ var elements = [...]string{
 	"DefinitionText",
 	"Bullet",
 	"Escape",
+	"DirectiveMark",
+	"DirectiveType",
+	"DirectiveTypeSuffix",
+	"DirectiveArgument",
+	"DirectiveBlock",
 }
 
 // String implements the Stringer interface for printing Type types.
@@ -118,10 +134,32 @@ type Item struct {
 	Line          int    `json:"line"`
 	StartPosition int    `json:"startPosition"`
 	Length        int    `json:"length,omitempty"`
+
+	// The location of the item in the Source of the lexer, set by Locate. Offset and EndOffset are byte offsets into the
+	// source from 0, Column and EndColumn are counted in runes from 1. The end of the item is the position after its last
+	// character. Line and StartPosition are located in the Input of the lexer, where tabs are expanded, and StartPosition
+	// is counted in runes from 1.
+	Offset    int `json:"offset,omitempty"`
+	EndOffset int `json:"endOffset,omitempty"`
+	Column    int `json:"column,omitempty"`
+	EndLine   int `json:"endLine,omitempty"`
+	EndColumn int `json:"endColumn,omitempty"`
 }
 
-// MarshalJSON satisfies the Marshaler interface.
+// MarshalJSON satisfies the Marshaler interface. The location fields set by Locate are only encoded if the item was
+// located, the Column of a located item is at least 1.
 func (i Item) MarshalJSON() ([]byte, error) {
+	type location struct {
+		Offset    int `json:"offset"`
+		EndOffset int `json:"endOffset"`
+		Column    int `json:"column"`
+		EndLine   int `json:"endLine"`
+		EndColumn int `json:"endColumn"`
+	}
+	var loc *location
+	if i.Column > 0 {
+		loc = &location{i.Offset, i.EndOffset, i.Column, i.EndLine, i.EndColumn}
+	}
 	return json.Marshal(&struct {
 		ID            int    `json:"id"`
 		Type          string `json:"type"`
@@ -129,6 +167,7 @@ func (i Item) MarshalJSON() ([]byte, error) {
 		Line          int    `json:"line"`
 		StartPosition int    `json:"startPosition"`
 		Length        int    `json:"length,omitempty"`
+		*location
 	}{
 		ID:            int(i.IDNumber()),
 		Type:          i.Type.String(),
@@ -136,6 +175,7 @@ func (i Item) MarshalJSON() ([]byte, error) {
 		Line:          i.Line,
 		StartPosition: i.StartPosition,
 		Length:        i.Length,
+		location:      loc,
 	})
 }
 
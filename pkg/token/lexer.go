@@ -17,6 +17,7 @@ type stateFn func(*Lexer) stateFn
 type Lexer struct {
 	Name    string   // The name of the current lexer
 	input   string   // The input text
+	source  string   // The input text before whitespace normalization
 	line    int      // Line number of the parser, from 0
 	numLine int      // Total number of input lines
 	state   stateFn  // The current state of the lexer
@@ -35,12 +36,23 @@ type Lexer struct {
 	indentLevel int    // For tracking indentation with indentable items
 	indentWidth string // For tracking indent width
 
+	// LineOffset and PositionOffset are added to the Line and StartPosition of every item returned by NextItem. They are
+	// used when the input is a block of text taken from a larger document, such as the content of a directive.
+	LineOffset     int
+	PositionOffset int
+
+	// Index is the index of the input the items returned by NextItem are located in. It is the index of the input of the
+	// lexer unless the lexer is located Within another lexer. The items are not located if Index is nil. starts contains
+	// the offset in the Index of the start of each line of the input.
+	Index  *LineIndex
+	starts []int
+
 	logConf log.Config
 
 	log.Logger
 }
 
-func newLexer(name string, input []byte, logConf log.Config) (l *Lexer, err error) {
+func newLexer(name string, input []byte, tabWidth int, logConf log.Config) (l *Lexer, err error) {
 	if len(input) == 0 {
 		err = errors.New("no input given")
 		return
@@ -60,14 +72,21 @@ func newLexer(name string, input []byte, logConf log.Config) (l *Lexer, err erro
 		err = fmt.Errorf("could not normalize input: %s", err)
 		return
 	}
+	if tabWidth < 1 {
+		tabWidth = DefaultTabWidth
+	}
+	text := normalizeWhitespace(string(ni), tabWidth)
 
-	lines := strings.Split(string(ni), "\n")
+	lines := strings.Split(text, "\n")
 
 	mark, width := utf8.DecodeRuneInString(lines[0][0:])
 	l.Log("mark", mark, "index", 0, "line", 1)
 
-	l.input = string(ni) // stored string is never altered
+	l.input = text // stored string is never altered
+	l.source = string(ni)
 	l.lines = lines
+	l.Index = newLineIndex(text, l.source, tabWidth)
+	l.starts = l.Index.starts
 	l.items = make(chan Item)
 	l.index = 0
 	l.mark = mark
@@ -77,9 +96,10 @@ func newLexer(name string, input []byte, logConf log.Config) (l *Lexer, err erro
 }
 
 // lex is the entry point of the lexer. Name should be any name that signifies the purporse of the lexer. It is mostly used
-// to identify the lexing process in debugging.
-func Lex(name string, input []byte, logConf log.Config) (l *Lexer, err error) {
-	l, err = newLexer(name, input, logConf)
+// to identify the lexing process in debugging. Tabs in the input are expanded to spaces with tab stops every tabWidth
+// columns, if tabWidth is less than 1 DefaultTabWidth is used. Form feeds and vertical tabs are converted to spaces.
+func Lex(name string, input []byte, tabWidth int, logConf log.Config) (l *Lexer, err error) {
+	l, err = newLexer(name, input, tabWidth, logConf)
 	if err != nil {
 		return
 	}
@@ -117,8 +137,8 @@ func (l *Lexer) emit(t Type) {
 		Type: t,
 		Text: tok,
 		Line: l.lineNumber(),
-		// +1 because positions begin at 1, not 0
-		StartPosition: l.start + 1,
+		// Positions are counted in runes from 1
+		StartPosition: utf8.RuneCountInString(l.lines[l.line][:l.start]) + 1,
 		Length:        length,
 	}
 
@@ -232,8 +252,20 @@ func (l *Lexer) NextItem() *Item {
 		return nil
 	}
 	l.lastItemPosition = item.StartPosition
-	return &item
+	item.Line += l.LineOffset
+	item.StartPosition += l.PositionOffset
+	return l.Locate(&item)
+}
+
+// Input returns the normalized input of the lexer. The lines and positions of items refer to this text.
+func (l *Lexer) Input() string {
+	return l.input
+}
 
+// Source returns the input of the lexer before whitespace normalization. Tabs, form feeds and vertical tabs are not
+// converted to spaces, otherwise the lines are the lines of Input.
+func (l *Lexer) Source() string {
+	return l.source
 }
 
 // gotoLine advances the lexer to a line and index within that line. Line numbers start at 1.
@@ -287,7 +319,9 @@ func lexStart(l *Lexer) stateFn {
 			}
 			l.Log("mark", fmt.Sprintf("%#U", l.mark), "start", l.start, "index", l.index,
 				"width", l.width, "line", l.lineNumber())
-			if isComment(l) {
+			if isDirective(l) {
+				return lexDirective
+			} else if isComment(l) {
 				return lexComment
 			} else if isHyperlinkTarget(l) {
 				return lexHyperlinkTarget
@@ -1,37 +1,237 @@
 package token
 
-import "unicode"
-
-// isArabic returns true if rune r is an Arabic numeral.
-func isArabic(r rune) bool {
-	return r > '0' && r < '9'
-}
-
-func isEnumList(l *Lexer) (ret bool) {
-	bCount := 0
-	if isSection(l) {
-		goto exit
-	}
-	if isArabic(l.mark) {
-		for {
-			bCount++
-			if nMark, _ := l.next(); !isArabic(nMark) {
-				if nMark == '.' || nMark == ' ' {
-					l.Msg("Found arabic enum list!")
-					ret = true
-					goto exit
-				}
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Enumerator is the enumerator of an enumerated list item, i.e., "1." or "(a)".
+type Enumerator struct {
+	// Sequence is the enumeration sequence of Text. It is one of "arabic", "loweralpha", "upperalpha", "lowerroman",
+	// "upperroman", or "#" for the auto enumerator.
+	Sequence string
+	Prefix   string // The prefix of the enumerator, "(" or empty
+	Text     string // The enumerator without affixes
+	Suffix   string // The suffix of the enumerator, ")" or "."
+	Ordinal  int    // The ordinal of Text in Sequence, the auto enumerator has the ordinal 1
+	Width    int    // The width of the enumerator including the affixes and the white space following it
+}
+
+// enumeratorText matches the text of an enumerator without the affixes.
+const enumeratorText = `[0-9]+|[a-zA-Z]|[ivxlcdm]+|[IVXLCDM]+|#`
+
+// enumerator matches an enumerator with affixes at the start of a line. The enumerator must be followed by white space or
+// the end of the line, i.e., "2.99" is not an enumerator.
+var enumerator = regexp.MustCompile(`^(?:\((` + enumeratorText + `)\)|(` + enumeratorText + `)([.)]))(?: +|$)`)
+
+// enumSequences are the enumeration sequences in the order they are tried when the sequence of an enumerator is ambiguous.
+var enumSequences = []struct {
+	name string
+	re   *regexp.Regexp
+}{
+	{"arabic", regexp.MustCompile(`^[0-9]+$`)},
+	{"loweralpha", regexp.MustCompile(`^[a-z]$`)},
+	{"upperalpha", regexp.MustCompile(`^[A-Z]$`)},
+	{"lowerroman", regexp.MustCompile(`^[ivxlcdm]+$`)},
+	{"upperroman", regexp.MustCompile(`^[IVXLCDM]+$`)},
+}
+
+// enumSequenceTypes are the item types emitted for the enumerators of each sequence.
+var enumSequenceTypes = map[string]Type{
+	"arabic":     EnumListArabic,
+	"loweralpha": EnumListAlpha,
+	"upperalpha": EnumListAlpha,
+	"lowerroman": EnumListRoman,
+	"upperroman": EnumListRoman,
+	"#":          EnumListAuto,
+}
+
+// ParseEnumerator parses the enumerator at the start of line. If the enumerator matches the expected sequence, it is parsed
+// as a member of that sequence. Otherwise the single letters "i" and "I" are roman numerals, other single letters are
+// alphabetic, and longer sequences of letters are roman numerals. Nil is returned if line does not begin with an
+// enumerator or the enumerator is an invalid roman numeral. This is parse_enumerator of docutils.
+func ParseEnumerator(line, expected string) *Enumerator {
+	m := enumerator.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	e := &Enumerator{Text: m[2], Suffix: m[3], Width: len(m[0])}
+	if m[1] != "" {
+		e.Prefix, e.Text, e.Suffix = "(", m[1], ")"
+	}
+	switch {
+	case e.Text == "#":
+		e.Sequence = "#"
+	case expected != "" && matchesSequence(expected, e.Text):
+		e.Sequence = expected
+	case e.Text == "i":
+		e.Sequence = "lowerroman"
+	case e.Text == "I":
+		e.Sequence = "upperroman"
+	default:
+		for _, s := range enumSequences {
+			if s.re.MatchString(e.Text) {
+				e.Sequence = s.name
+				break
 			}
 		}
 	}
-exit:
-	l.backup(bCount)
-	return
+	switch e.Sequence {
+	case "#":
+		e.Ordinal = 1
+	case "arabic":
+		e.Ordinal, _ = strconv.Atoi(e.Text)
+	case "loweralpha":
+		e.Ordinal = int(e.Text[0]-'a') + 1
+	case "upperalpha":
+		e.Ordinal = int(e.Text[0]-'A') + 1
+	default:
+		if e.Ordinal = fromRoman(strings.ToUpper(e.Text)); e.Ordinal == 0 {
+			return nil
+		}
+	}
+	return e
+}
+
+// matchesSequence returns true if text is an enumerator of the sequence named name.
+func matchesSequence(name, text string) bool {
+	for _, s := range enumSequences {
+		if s.name == name {
+			return s.re.MatchString(text)
+		}
+	}
+	return false
+}
+
+// Type returns the item type emitted for the enumerator.
+func (e *Enumerator) Type() Type { return enumSequenceTypes[e.Sequence] }
+
+// next returns the enumerator following e in the sequence of e, and the auto enumerator, both with the affixes of e. next
+// is empty if the sequence has no more enumerators.
+func (e *Enumerator) next() (next, auto string) {
+	var text string
+	switch o := e.Ordinal + 1; e.Sequence {
+	case "#":
+		text = "#"
+	case "arabic":
+		text = strconv.Itoa(o)
+	case "loweralpha", "upperalpha":
+		if o > 26 {
+			return "", ""
+		}
+		text = string(rune('a' + o - 1))
+		if e.Sequence == "upperalpha" {
+			text = strings.ToUpper(text)
+		}
+	default:
+		if text = toRoman(o); text == "" {
+			return "", ""
+		}
+		if e.Sequence == "lowerroman" {
+			text = strings.ToLower(text)
+		}
+	}
+	return e.Prefix + text + e.Suffix, e.Prefix + "#" + e.Suffix
 }
 
+// IsListItem returns true if the enumerator begins a list item given the line following it. Text beginning with an
+// enumerator is only a list item if the next line is blank, indented, or begins with the next enumerator of the sequence.
+// nextLine is empty at the end of the input. This is is_enumerated_list_item of docutils.
+func (e *Enumerator) IsListItem(nextLine string) bool {
+	if nextLine == "" || unicode.IsSpace(rune(nextLine[0])) {
+		return true
+	}
+	next, auto := e.next()
+	if next == "" {
+		return false
+	}
+	return strings.HasPrefix(nextLine, next) || strings.HasPrefix(nextLine, auto)
+}
+
+// romanNumerals are the values of roman numerals, largest first, including the subtractive pairs.
+var romanNumerals = []struct {
+	numeral string
+	value   int
+}{
+	{"M", 1000}, {"CM", 900}, {"D", 500}, {"CD", 400}, {"C", 100}, {"XC", 90}, {"L", 50}, {"XL", 40}, {"X", 10},
+	{"IX", 9}, {"V", 5}, {"IV", 4}, {"I", 1},
+}
+
+// toRoman returns n as an uppercase roman numeral. An empty string is returned if n is not between 1 and 4999.
+func toRoman(n int) string {
+	if n < 1 || n > 4999 {
+		return ""
+	}
+	var s string
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			s += r.numeral
+			n -= r.value
+		}
+	}
+	return s
+}
+
+// fromRoman returns the value of the uppercase roman numeral s, or zero if s is not a valid roman numeral.
+func fromRoman(s string) int {
+	var n int
+	rest := s
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(rest, r.numeral) {
+			n += r.value
+			rest = rest[len(r.numeral):]
+		}
+	}
+	// Only the canonical form is valid, i.e., "IIII" is not
+	if rest != "" || toRoman(n) != s {
+		return 0
+	}
+	return n
+}
+
+// isEnumList returns true if the lexer is at the enumerator of an enumerated list item. The enumerator must be the first
+// text on the line.
+func isEnumList(l *Lexer) bool {
+	if isSection(l) || strings.TrimSpace(l.currentLine()[:l.index]) != "" {
+		return false
+	}
+	e := ParseEnumerator(l.currentLine()[l.index:], "")
+	if e == nil {
+		return false
+	}
+	// The next line relative to the enumerator, a less indented line ends the block containing the list
+	next := l.peekNextLine()
+	if len(next) >= l.index && strings.TrimSpace(next[:l.index]) == "" {
+		next = next[l.index:]
+	} else {
+		next = ""
+	}
+	if !e.IsListItem(next) {
+		l.Msg("Enumerator is not a list item")
+		return false
+	}
+	l.Msgr("Found enum list", "enumerator", e.Text, "sequence", e.Sequence)
+	return true
+}
+
+// isBulletList returns true if the lexer is at the bullet of a bullet list item. The bullet must be the first text on the
+// line and must be followed by white space or the end of the line. A bullet alone on a line that underlines or overlines a
+// section title is a section adornment.
 func isBulletList(l *Lexer) bool {
+	if strings.TrimSpace(l.currentLine()[:l.index]) != "" {
+		return false
+	}
 	for _, x := range bullets {
-		if l.mark == x && l.peek(1) == ' ' {
+		if l.mark != x {
+			continue
+		}
+		if l.index+l.width == len(l.currentLine()) && isSectionAdornmentLine(l) {
+			l.Msg("Found section adornment")
+			return false
+		}
+		if l.peek(1) == ' ' || l.index+l.width == len(l.currentLine()) {
 			l.Msg("A bullet was found")
 			return true
 		}
@@ -40,6 +240,18 @@ func isBulletList(l *Lexer) bool {
 	return false
 }
 
+// isSectionAdornmentLine returns true if the current line is the underline of the title emitted before it, or the overline
+// of a title on the next line.
+func isSectionAdornmentLine(l *Lexer) bool {
+	if l.lastItem != nil && l.lastItem.Type == Title {
+		return true
+	}
+	if l.line+2 >= len(l.lines) || strings.TrimSpace(l.lines[l.line+1]) == "" {
+		return false
+	}
+	return strings.TrimSpace(l.lines[l.line+2]) == strings.TrimSpace(l.currentLine())
+}
+
 func isDefinitionTerm(l *Lexer) bool {
 	// Definition terms are preceded by a blankline
 	if l.line != 0 && !l.lastLineIsBlankLine() {
@@ -56,7 +268,7 @@ func isDefinitionTerm(l *Lexer) bool {
 		}
 	}
 	l.Msgr("Section count", "sCount", sCount)
-	if sCount >= 2 {
+	if sCount > 0 && strings.TrimSpace(nL) != "" {
 		l.Msg("FOUND definition term!")
 		return true
 	}
@@ -64,21 +276,27 @@ func isDefinitionTerm(l *Lexer) bool {
 	return false
 }
 
+// lexEnumList emits the affixes and the enumerator of an enumerated list item, followed by the white space after the
+// enumerator.
 func lexEnumList(l *Lexer) stateFn {
-	if isArabic(l.mark) {
-		for {
-			if nMark, _ := l.next(); !isArabic(nMark) {
-				l.emit(EnumListArabic)
-				l.next()
-				if nMark == '.' {
-					l.emit(EnumListAffix)
-					l.next()
-				}
-				l.emit(Space)
-				break
-			}
+	e := ParseEnumerator(l.currentLine()[l.index:], "")
+	advance := func(n int) {
+		for x := 0; x < n; x++ {
+			l.next()
 		}
 	}
+	if e.Prefix != "" {
+		advance(len(e.Prefix))
+		l.emit(EnumListAffix)
+	}
+	advance(len(e.Text))
+	l.emit(e.Type())
+	advance(len(e.Suffix))
+	l.emit(EnumListAffix)
+	if spaces := e.Width - len(e.Prefix+e.Text+e.Suffix); spaces > 0 {
+		advance(spaces)
+		l.emit(Space)
+	}
 	return lexStart
 }
 
@@ -104,12 +322,18 @@ func lexDefinitionTerm(l *Lexer) stateFn {
 	return lexStart
 }
 
+// lexBullet emits the bullet of a bullet list item, followed by the white space and the text after the bullet. An empty
+// item has only the bullet.
 func lexBullet(l *Lexer) stateFn {
 	l.next()
 	l.emit(Bullet)
+	l.indentLevel++
+	if l.isEndOfLine() {
+		l.indentWidth += l.lastItem.Text
+		return lexStart
+	}
 	lexSpace(l)
 	l.indentWidth += l.lastItem.Text + " "
 	lexText(l)
-	l.indentLevel++
 	return lexStart
 }
@@ -4,8 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
+// DefaultTabWidth is the number of columns between tab stops used to expand tabs in the input if no tab width is given.
+// This is the default tab_width setting of docutils.
+const DefaultTabWidth = 8
+
 // normalize converts '\u2138' to 'ℸ' and '\xab' to '«' from the input byte slice. A byte slice is returned with the
 // conversions. An error is returned if the unicode literals are invalid.
 func normalize(input []byte) (out []byte, err error) {
@@ -47,3 +52,37 @@ func normalize(input []byte) (out []byte, err error) {
 	}
 	return
 }
+
+// normalizeWhitespace expands the tabs in text to spaces with tab stops every tabWidth columns, and converts form feeds and
+// vertical tabs to single spaces. The number of lines is not changed, so the lines of the returned text match the lines of
+// text.
+func normalizeWhitespace(text string, tabWidth int) string {
+	if !strings.ContainsAny(text, "\t\f\v") {
+		return text
+	}
+	return strings.NewReplacer("\f", " ", "\v", " ").Replace(ExpandTabs(text, tabWidth))
+}
+
+// ExpandTabs replaces the tabs in text with spaces up to the next tab stop. Tab stops are every width columns.
+func ExpandTabs(text string, width int) string {
+	if !strings.Contains(text, "\t") {
+		return text
+	}
+	var buf strings.Builder
+	col := 0
+	for _, r := range text {
+		switch r {
+		case '\t':
+			n := width - col%width
+			buf.WriteString(strings.Repeat(" ", n))
+			col += n
+		case '\n':
+			buf.WriteRune(r)
+			col = 0
+		default:
+			buf.WriteRune(r)
+			col++
+		}
+	}
+	return buf.String()
+}
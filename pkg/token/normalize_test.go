@@ -77,3 +77,17 @@ func TestNormalize(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeWhitespace(t *testing.T) {
+	tests := []struct{ input, expect string }{
+		{"a\tb\n\tc", "a   b\n    c"},
+		{"ab\tc", "ab  c"},
+		{"a\fb\vc", "a b c"},
+		{"\f\tx", "    x"},
+	}
+	for _, tt := range tests {
+		if got := normalizeWhitespace(tt.input, 4); got != tt.expect {
+			t.Errorf("normalizeWhitespace(%q, 4) = %q, expect %q", tt.input, got, tt.expect)
+		}
+	}
+}
@@ -8,7 +8,13 @@ func lexText(l *Lexer) stateFn {
 			l.emit(Text)
 			lexEscape(l)
 		}
-		if isInlineMarkup(l) {
+		if isInlineRolePrefix(l) {
+			if l.index > l.start {
+				l.emit(Text)
+			}
+			lexInlineInterpretedTextRole(l)
+			continue
+		} else if isInlineMarkup(l) {
 			l.Msg("FOUND inline reference!")
 			if l.index > l.start {
 				l.emit(Text)
@@ -0,0 +1,220 @@
+package token
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LineIndex converts the line numbers and StartPositions of a text to byte offsets and to columns counted in runes. The
+// text may be the source of the index with its whitespace normalized, the offsets and columns are then located in the
+// source. Normalization must not change the number of lines.
+type LineIndex struct {
+	text     string
+	starts   []int  // The byte offset of the start of each line
+	source   string // The text before whitespace normalization
+	sources  []int  // The byte offset of the start of each line of the source
+	tabWidth int    // The tab width used to normalize the source
+}
+
+// NewLineIndex returns the LineIndex of text.
+func NewLineIndex(text string) *LineIndex {
+	return newLineIndex(text, text, DefaultTabWidth)
+}
+
+// newLineIndex returns the LineIndex of text, the source with tabs expanded to tabWidth and form feeds and vertical tabs
+// converted to spaces by normalizeWhitespace.
+func newLineIndex(text, source string, tabWidth int) *LineIndex {
+	x := &LineIndex{text: text, starts: lineStarts(text), source: source, tabWidth: tabWidth}
+	x.sources = x.starts
+	if source != text {
+		x.sources = lineStarts(source)
+	}
+	return x
+}
+
+// lineStarts returns the byte offset of the start of each line of text.
+func lineStarts(text string) []int {
+	starts := []int{0}
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+// line returns the byte offset of the start of line number n and the text of the line. False is returned if the line is
+// not in the text.
+func (x *LineIndex) line(n int) (int, string, bool) {
+	n--
+	if n < 0 || n >= len(x.starts) {
+		return 0, "", false
+	}
+	start, end := x.starts[n], len(x.text)
+	if n+1 < len(x.starts) {
+		end = x.starts[n+1] - 1
+	}
+	return start, x.text[start:end], true
+}
+
+// start returns the byte offset into the text of the start of line number n. Lines before the text start at offset 0 and
+// lines after the text start at the end of the text.
+func (x *LineIndex) start(n int) int {
+	if n < 1 {
+		return 0
+	}
+	if n > len(x.starts) {
+		return len(x.text)
+	}
+	return x.starts[n-1]
+}
+
+// sourceLine returns the byte offset into the source of the start of line number n, which must be in the text, and the
+// source of the line.
+func (x *LineIndex) sourceLine(n int) (int, string) {
+	start, end := x.sources[n-1], len(x.source)
+	if n < len(x.sources) {
+		end = x.sources[n] - 1
+	}
+	return start, x.source[start:end]
+}
+
+// sourceOffset returns the byte offset into src of the byte offset pos into text, the line src with its whitespace
+// normalized. An offset inside an expanded tab is the offset of the tab.
+func (x *LineIndex) sourceOffset(text, src string, pos int) int {
+	if text == src {
+		return pos
+	}
+	n, col := 0, 0 // The byte offset and column in text of the rune at i
+	for i, r := range src {
+		w, c := utf8.RuneLen(r), 1
+		if r == '\t' {
+			w = x.tabWidth - col%x.tabWidth
+			c = w
+		}
+		if n+w > pos {
+			return i
+		}
+		n, col = n+w, col+c
+	}
+	return len(src)
+}
+
+// bytePos returns the byte offset in text of the rune at index n, counted from 0. Runes after the end of text are counted
+// as one byte each.
+func bytePos(text string, n int) int {
+	n = max(n, 0)
+	for i := range text {
+		if n == 0 {
+			return i
+		}
+		n--
+	}
+	return len(text) + n
+}
+
+// Offset returns the byte offset into the source of the StartPosition startPosition, counted in runes from 1, on line
+// number line. Positions before the start of the text are at offset 0 and positions after the end of the text are at the
+// end of the source.
+func (x *LineIndex) Offset(line, startPosition int) int {
+	_, text, _ := x.line(line)
+	return x.offset(line, bytePos(text, startPosition-1))
+}
+
+// Column returns the column in the source, counted in runes from 1, of the StartPosition startPosition on line number line.
+// Positions after the end of the line are counted as spaces added to the line.
+func (x *LineIndex) Column(line, startPosition int) int {
+	_, text, _ := x.line(line)
+	return x.column(line, bytePos(text, startPosition-1))
+}
+
+// offset returns the byte offset into the source of the byte offset pos into line number line of the text.
+func (x *LineIndex) offset(line, pos int) int {
+	_, text, ok := x.line(line)
+	if !ok {
+		if line < 1 {
+			return 0
+		}
+		return len(x.source)
+	}
+	start, src := x.sourceLine(line)
+	return start + x.sourceOffset(text, src, min(max(pos, 0), len(text)))
+}
+
+// column returns the column in the source, counted in runes from 1, of the byte offset pos into line number line of the
+// text.
+func (x *LineIndex) column(line, pos int) int {
+	_, text, ok := x.line(line)
+	pos = max(pos, 0)
+	if !ok {
+		return pos + 1
+	}
+	_, src := x.sourceLine(line)
+	if pos > len(text) {
+		return utf8.RuneCountInString(src) + pos - len(text) + 1
+	}
+	return utf8.RuneCountInString(src[:x.sourceOffset(text, src, pos)]) + 1
+}
+
+// Within sets l to locate its items in the input of the lexer parent. The input of l must be a block of lines taken from
+// the input of parent, and LineOffset and PositionOffset of l must be set to the position of the block in the input of
+// parent. The lines of the block may be dedented or begin after a list item bullet, but they must end as the lines of
+// parent do.
+func (l *Lexer) Within(parent *Lexer) {
+	l.Index = parent.Index
+	if l.Index == nil {
+		return
+	}
+	l.starts = make([]int, len(l.lines))
+	for k, s := range l.lines {
+		line := l.LineOffset + k + 1
+		j := line - parent.LineOffset - 1
+		switch {
+		case j < 0 || j >= len(parent.lines):
+			l.starts[k] = parent.lineStart(line) + l.PositionOffset - parent.PositionOffset
+		case strings.HasSuffix(parent.lines[j], s):
+			l.starts[k] = parent.starts[j] + len(parent.lines[j]) - len(s)
+		default:
+			l.starts[k] = parent.starts[j] + l.PositionOffset - parent.PositionOffset
+		}
+	}
+}
+
+// lineStart returns the offset in the Index of the start of line number line of the input. The line number must include
+// LineOffset. The start of a line that is not in the input is the start of the line in the Index indented by
+// PositionOffset.
+func (l *Lexer) lineStart(line int) int {
+	if k := line - l.LineOffset - 1; k >= 0 && k < len(l.starts) {
+		return l.starts[k]
+	}
+	return l.Index.start(line) + l.PositionOffset
+}
+
+// Locate sets the Offset, Column, EndOffset, EndLine and EndColumn of i in the Source of the lexer from its Line,
+// StartPosition and Text. The Line and StartPosition of i must include LineOffset and PositionOffset. An item with text
+// spanning several lines ends at the end of its last line, the text of these items is often dedented or otherwise changed
+// from the input. If the lexer does not have an Index, i is not changed.
+func (l *Lexer) Locate(i *Item) *Item {
+	if l.Index == nil {
+		return i
+	}
+	// The byte offset of the item in the line of the Index. The line of the input starts at prefix in that line.
+	_, line, _ := l.Index.line(i.Line)
+	prefix := min(max(l.lineStart(i.Line)-l.Index.start(i.Line), 0), len(line))
+	pos := prefix + bytePos(line[prefix:], i.StartPosition-l.PositionOffset-1)
+	i.Offset, i.Column = l.Index.offset(i.Line, pos), l.Index.column(i.Line, pos)
+	text := i.Text
+	if i.Type == BlankLine {
+		text = ""
+	}
+	i.EndLine = i.Line + strings.Count(text, "\n")
+	if i.EndLine == i.Line {
+		i.EndOffset = l.Index.offset(i.Line, pos+len(text))
+		i.EndColumn = l.Index.column(i.Line, pos+len(text))
+		return i
+	}
+	_, last, _ := l.Index.line(i.EndLine)
+	i.EndOffset = l.Index.offset(i.EndLine, len(last))
+	i.EndColumn = l.Index.column(i.EndLine, len(last))
+	return i
+}
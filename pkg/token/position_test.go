@@ -0,0 +1,79 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/demizer/go-rst/pkg/testutil"
+)
+
+type locateTest struct {
+	text                                       string
+	offset, endOffset, column, endLine, endCol int
+}
+
+// checkLocations lexes the items of l until EOF and checks the location of the items with text in expect.
+func checkLocations(t *testing.T, l *Lexer, expect []locateTest) {
+	for _, e := range expect {
+		var i *Item
+		for i = l.NextItem(); i.Type != EOF && i.Text != e.text; i = l.NextItem() {
+		}
+		if i.Type == EOF {
+			t.Fatalf("item %q not found", e.text)
+		}
+		if i.Offset != e.offset || i.EndOffset != e.endOffset || i.Column != e.column || i.EndLine != e.endLine ||
+			i.EndColumn != e.endCol {
+			t.Errorf("item %q: got offset %d-%d, column %d, end %d:%d, expect offset %d-%d, column %d, end %d:%d",
+				i.Text, i.Offset, i.EndOffset, i.Column, i.EndLine, i.EndColumn, e.offset, e.endOffset, e.column,
+				e.endLine, e.endCol)
+		}
+	}
+}
+
+func TestLocate(t *testing.T) {
+	// "ü" and "ö" are two bytes, "世界" is six bytes.
+	input := "Über\n====\n\nGrüße *wörld* 世界.\n"
+	l, err := Lex("locate", []byte(input), 0, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLocations(t, l, []locateTest{
+		{"Über", 0, 5, 1, 1, 5},
+		{"====", 6, 10, 1, 2, 5},
+		{"Grüße ", 12, 20, 1, 4, 7},
+		{"wörld", 21, 27, 8, 4, 13},
+		{" 世界.", 28, 36, 14, 4, 18},
+	})
+}
+
+func TestLocateWithin(t *testing.T) {
+	input := "• Item one\n  continues *here*.\n"
+	parent, err := Lex("parent", []byte(input), 0, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The content of the bullet list item, dedented by two columns. The bullet is three bytes.
+	l, err := Lex("within", []byte("Item one\ncontinues *here*.\n"), 0, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.PositionOffset = 2
+	l.Within(parent)
+	checkLocations(t, l, []locateTest{
+		{"Item one", 4, 12, 3, 1, 11},
+		{"here", 26, 30, 14, 2, 18},
+	})
+}
+
+func TestLocateTabs(t *testing.T) {
+	// The tabs are expanded in the input of the lexer, the items are located in the source.
+	input := "Text\twith *tab*.\n\n\tQuote.\n"
+	l, err := Lex("tabs", []byte(input), 0, testutil.LoggerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkLocations(t, l, []locateTest{
+		{"Text    with ", 0, 10, 1, 1, 11},
+		{"tab", 11, 14, 12, 1, 15},
+		{"Quote.", 19, 25, 2, 3, 8},
+	})
+}
@@ -179,6 +179,13 @@ func Test_01_00_01_00_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_01_00_01_01_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.00.01.01-target-name-inner-white-space")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_01_00_02_00_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
 		t.SkipNow()
@@ -200,9 +207,6 @@ func Test_01_00_02_01_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_03_00_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.03.00-bad-duplicate-implicit-targets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -210,9 +214,6 @@ func Test_01_00_03_00_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_03_01_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.03.01-bad-duplicate-implicit-explicit-targets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -230,9 +231,6 @@ func Test_01_00_03_02_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_00_04_00_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.00.04.00-bad-duplicate-explicit-targets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -259,6 +257,13 @@ func Test_01_00_04_02_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_01_00_04_03_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.00.04.03-bad-duplicate-explicit-target-directive-name")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_01_00_05_00_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
 		t.SkipNow()
@@ -293,6 +298,13 @@ func Test_01_01_00_01_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_01_01_00_02_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("01.01.00.02-consecutive-external-targets")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_01_01_01_00_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("01.01.01.00-external-target-mailto")
 	test := LoadLexTest(t, testPath)
@@ -301,9 +313,6 @@ func Test_01_01_01_00_LexerReferenceHyperlinkTargetsGood(t *testing.T) {
 }
 
 func Test_01_01_02_00_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.02.00-bad-duplicate-external-targets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -311,9 +320,6 @@ func Test_01_01_02_00_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
 }
 
 func Test_01_01_02_01_LexerReferenceHyperlinkTargetsBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("01.01.02.01-bad-duplicate-external-targets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -414,6 +420,13 @@ func Test_02_00_00_03_LexerParagraphGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_02_00_00_04_LexerParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.00.04-byte-order-mark")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_02_00_01_00_LexerParagraphGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("02.00.01.00-two-paragraphs")
 	test := LoadLexTest(t, testPath)
@@ -428,10 +441,28 @@ func Test_02_00_01_01_LexerParagraphGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_02_00_02_00_LexerParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.02.00-tabs-and-whitespace")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_02_00_03_00_LexerParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.03.00-locations-multibyte")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_02_00_03_01_LexerParagraphGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("02.00.03.01-locations-tabs")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_03_00_00_00_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.00-paragraph-blockquote")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -439,9 +470,6 @@ func Test_03_00_00_00_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_01_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.01-paragraph-blockquote-short-section")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -449,9 +477,6 @@ func Test_03_00_00_01_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_02_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.02-paragraph-blockquote-comment")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -459,9 +484,6 @@ func Test_03_00_00_02_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_00_03_LexerBlockquoteBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.03-bad-no-blank-line")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -469,9 +491,6 @@ func Test_03_00_00_03_LexerBlockquoteBad(t *testing.T) {
 }
 
 func Test_03_00_00_04_LexerBlockquoteBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.00.04-bad-unexpected-indent")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -479,9 +498,6 @@ func Test_03_00_00_04_LexerBlockquoteBad(t *testing.T) {
 }
 
 func Test_03_00_01_00_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.01.00-two-levels")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -489,9 +505,6 @@ func Test_03_00_01_00_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_02_00_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.02.00-unicode-em-dash")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -499,9 +512,6 @@ func Test_03_00_02_00_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_03_00_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.03.00-uneven-indents")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -509,9 +519,6 @@ func Test_03_00_03_00_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_00_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.00-paragraph-blockquote-attrib")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -519,9 +526,6 @@ func Test_03_00_04_00_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_01_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.01-paragraph-blockquote-two-line-attrib")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -529,9 +533,6 @@ func Test_03_00_04_01_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_02_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.02-paragraph-blockquote-attrib-no-space")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -539,9 +540,6 @@ func Test_03_00_04_02_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_03_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.03-paragraph-blockquote-one-attrib")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -549,9 +547,6 @@ func Test_03_00_04_03_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_04_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.04-paragraph-blockquote-attrib-invalid")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -559,9 +554,6 @@ func Test_03_00_04_04_LexerBlockquoteGood(t *testing.T) {
 }
 
 func Test_03_00_04_05_LexerBlockquoteGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("03.00.04.05-paragraph-blockquote-attrib-with-invalid-attrib")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -617,6 +609,34 @@ func Test_04_00_02_00_LexerSectionGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_04_00_02_01_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.01-one-char-title-dash-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_02_02_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.02-one-char-title-star-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_02_03_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.03-one-char-title-plus-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_02_04_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.02.04-bad-short-title-one-char-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_04_00_03_00_LexerSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.00.03.00-empty-section")
 	test := LoadLexTest(t, testPath)
@@ -645,6 +665,48 @@ func Test_04_00_05_00_LexerSectionGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_04_00_06_00_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.06.00-title-ids")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_07_00_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.00-title-wide-chars-chinese")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_07_01_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.01-title-wide-chars-emoji")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_07_02_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.02-title-wide-chars-japanese")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_07_03_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.03-bad-wide-chars-short-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_00_07_04_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.00.07.04-title-wide-chars-long-underline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_04_01_00_00_LexerSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.01.00.00-title-overline")
 	test := LoadLexTest(t, testPath)
@@ -764,6 +826,13 @@ func Test_04_01_07_00_LexerSectionBad(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_04_01_08_00_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.01.08.00-title-wide-chars-overline")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_04_02_00_00_LexerSectionGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("04.02.00.00-section-level-return")
 	test := LoadLexTest(t, testPath)
@@ -820,10 +889,63 @@ func Test_04_02_02_00_LexerSectionGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_04_03_00_00_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.00-transition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_00_01_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.01-bad-document-begins-with-transition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_00_02_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.02-bad-document-ends-with-transition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_00_03_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.03-bad-adjacent-transitions")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_00_04_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.00.04-mixed-characters-is-text")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_01_00_LexerSectionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.01.00-transition-at-end-of-section")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_01_01_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.01.01-bad-section-begins-with-transition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_04_03_02_00_LexerSectionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("04.03.02.00-bad-transition-in-block-quote")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_05_00_00_00_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.00-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -831,9 +953,6 @@ func Test_05_00_00_00_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_01_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.01-literal-block-space-after-colons")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -841,9 +960,6 @@ func Test_05_00_00_01_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_02_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.02-bad-unindented-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -851,9 +967,6 @@ func Test_05_00_00_02_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_03_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.03-bad-no-blankline-after-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -861,9 +974,6 @@ func Test_05_00_00_03_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_04_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.04-multiline-paragraph-before-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -871,9 +981,6 @@ func Test_05_00_00_04_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_05_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.05-bad-no-blankline-before-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -881,9 +988,6 @@ func Test_05_00_00_05_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_06_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.06-paragraph-space-double-colon-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -891,9 +995,6 @@ func Test_05_00_00_06_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_07_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.07-paragraph-colon-newline-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -901,9 +1002,6 @@ func Test_05_00_00_07_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_00_08_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.08-bad-section-underline-not-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -911,9 +1009,6 @@ func Test_05_00_00_08_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_00_09_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.00.09-bad-eof-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -921,9 +1016,6 @@ func Test_05_00_00_09_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_00_01_00_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.01.00-multiline-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -931,9 +1023,6 @@ func Test_05_00_01_00_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_01_01_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.01.01-wonky-multiline-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -941,9 +1030,6 @@ func Test_05_00_01_01_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_02_00_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.02.00-double-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -951,19 +1037,20 @@ func Test_05_00_02_00_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_00_02_01_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.00.02.01-literal-block-and-escaped-colon-blockquote")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_05_00_03_00_LexerLiteralBlockGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("05.00.03.00-literal-block-with-tabs")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_05_01_00_00_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.00.00-quoted-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -971,9 +1058,6 @@ func Test_05_01_00_00_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_00_01_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.00.01-quoted-literal-block-two-blanklines")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -981,9 +1065,6 @@ func Test_05_01_00_01_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_00_02_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.00.02-bad-inconsistent-quoted-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -991,9 +1072,6 @@ func Test_05_01_00_02_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_01_01_00_LexerLiteralBlockGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.00-quoted-literal-block-multiline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1001,9 +1079,6 @@ func Test_05_01_01_00_LexerLiteralBlockGood(t *testing.T) {
 }
 
 func Test_05_01_01_01_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.01-bad-indented-line-after-quoted-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1011,9 +1086,6 @@ func Test_05_01_01_01_LexerLiteralBlockBad(t *testing.T) {
 }
 
 func Test_05_01_01_02_LexerLiteralBlockBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("05.01.01.02-bad-unindented-line-after-quoted-literal-block")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1959,10 +2031,63 @@ func Test_06_11_03_00_LexerInlineMarkupGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
-func Test_07_00_00_00_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
+func Test_06_12_00_00_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.00-standard-roles")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_00_01_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.01-sub-and-superscript-roles")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_00_02_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.02-title-and-abbreviation-roles")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_00_03_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.03-pep-and-rfc-roles")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_00_04_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.04-derived-role-classes")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_00_05_LexerInlineMarkupGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.00.05-role-starts-paragraph")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_01_00_LexerInlineMarkupBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.01.00-bad-unknown-role")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_06_12_01_01_LexerInlineMarkupBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("06.12.01.01-bad-pep-number")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_07_00_00_00_LexerListBulletGood(t *testing.T) {
 	testPath := testutil.TestPathFromName("07.00.00.00-bullet-list")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1970,9 +2095,6 @@ func Test_07_00_00_00_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_01_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.01-bullet-list-with-two-items")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1980,9 +2102,6 @@ func Test_07_00_00_01_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_02_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.02-bullet-list-noblankline-between-items")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -1990,9 +2109,6 @@ func Test_07_00_00_02_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_00_03_LexerListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.00.03-bad-bullet-list-noblankline-at-end")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2000,9 +2116,6 @@ func Test_07_00_00_03_LexerListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_01_00_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.01.00-bullet-list-item-with-paragraph")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2010,9 +2123,6 @@ func Test_07_00_01_00_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_01_01_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.01.01-bullet-list-item-with-paragraph")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2020,9 +2130,6 @@ func Test_07_00_01_01_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_02_00_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.02.00-bullet-list-different-bullets")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2030,9 +2137,6 @@ func Test_07_00_02_00_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_02_01_LexerListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.02.01-bad-bullet-list-different-bullets-missing-blankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2040,9 +2144,6 @@ func Test_07_00_02_01_LexerListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_03_00_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.03.00-bullet-list-empty-item")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2050,9 +2151,6 @@ func Test_07_00_03_00_LexerListBulletGood(t *testing.T) {
 }
 
 func Test_07_00_03_01_LexerListBulletBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.03.01-bad-bullet-list-empty-item-noblankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2060,19 +2158,20 @@ func Test_07_00_03_01_LexerListBulletBad(t *testing.T) {
 }
 
 func Test_07_00_04_00_LexerListBulletGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("07.00.04.00-bullet-list-unicode")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_07_00_05_00_LexerListBulletGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("07.00.05.00-bullet-list-nested")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
 func Test_08_00_00_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.00-numbered")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2080,9 +2179,6 @@ func Test_08_00_00_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_01_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.01-numbered-noblanklines")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2090,9 +2186,6 @@ func Test_08_00_00_01_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_02_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.02-numbered-indented-items")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2100,9 +2193,6 @@ func Test_08_00_00_02_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_00_03_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.03-bad-enum-list-empty-item-noblankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2110,9 +2200,6 @@ func Test_08_00_00_03_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_04_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.04-bad-enum-list-scrambled-items")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2120,9 +2207,6 @@ func Test_08_00_00_04_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_05_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.05-bad-enum-list-skipped-item")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2130,9 +2214,6 @@ func Test_08_00_00_05_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_00_06_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.00.06-bad-enum-list-not-ordinal-1")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2140,9 +2221,6 @@ func Test_08_00_00_06_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_01_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.00-alphabetical-list")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2150,9 +2228,6 @@ func Test_08_00_01_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_01_01_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.01-bad-alphabetical-list-without-blankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2160,9 +2235,6 @@ func Test_08_00_01_01_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_01_02_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.01.02-alphabetical-list-nbsp-workaround")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2170,9 +2242,6 @@ func Test_08_00_01_02_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_02_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.02.00-items-with-paragraphs")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2180,9 +2249,6 @@ func Test_08_00_02_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_02_01_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.02.01-bad-enum-list-unexpected-unindent")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2190,9 +2256,6 @@ func Test_08_00_02_01_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_03_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.03.00-diff-formats")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2200,9 +2263,6 @@ func Test_08_00_03_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_04_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.04.00-roman-numerals")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2210,9 +2270,6 @@ func Test_08_00_04_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_04_01_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.04.01-bad-enum-list-bad-roman-numerals")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2220,9 +2277,6 @@ func Test_08_00_04_01_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_05_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.05.00-nested")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2230,9 +2284,6 @@ func Test_08_00_05_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.00-sequence-types")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2240,9 +2291,6 @@ func Test_08_00_06_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_01_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.01-ambiguous-sequence-types")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2250,9 +2298,6 @@ func Test_08_00_06_01_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_06_02_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.06.02-bad-enum-list-ambiguous")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2260,9 +2305,6 @@ func Test_08_00_06_02_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_07_00_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.00-auto-numbering")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2270,9 +2312,6 @@ func Test_08_00_07_00_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_01_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.01-auto-numbering")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2280,9 +2319,6 @@ func Test_08_00_07_01_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_02_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.02-auto-numbering")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2290,9 +2326,6 @@ func Test_08_00_07_02_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_03_LexerListEnumeratedGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.03-auto-numbering")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2300,9 +2333,6 @@ func Test_08_00_07_03_LexerListEnumeratedGood(t *testing.T) {
 }
 
 func Test_08_00_07_04_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.07.04-bad-enum-list-auto-numbering-noblankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2310,9 +2340,6 @@ func Test_08_00_07_04_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_08_00_08_00_LexerListEnumeratedBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("08.00.08.00-bad-enum-list-paragraph-not-list")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2320,9 +2347,6 @@ func Test_08_00_08_00_LexerListEnumeratedBad(t *testing.T) {
 }
 
 func Test_09_00_00_01_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.01-with-paragraph")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2330,9 +2354,6 @@ func Test_09_00_00_01_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_00_02_LexerListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.02-bad-def-list-noblankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2340,9 +2361,6 @@ func Test_09_00_00_02_LexerListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_00_03_LexerListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.00.03-bad-def-list-not-def-term")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2350,9 +2368,6 @@ func Test_09_00_00_03_LexerListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_01_00_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.00-two-terms")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2360,9 +2375,6 @@ func Test_09_00_01_00_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_01_01_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.01-two-terms-noblankline")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2370,9 +2382,6 @@ func Test_09_00_01_01_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_01_02_LexerListDefinitionBad(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.01.02-bad-def-list-noblankline-after-two-terms")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2380,9 +2389,6 @@ func Test_09_00_01_02_LexerListDefinitionBad(t *testing.T) {
 }
 
 func Test_09_00_02_00_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.02.00-nested-terms")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2390,9 +2396,6 @@ func Test_09_00_02_00_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_03_00_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.03.00-term-with-classifier")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2400,9 +2403,6 @@ func Test_09_00_03_00_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_00_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.00-term-not-classifier")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2410,9 +2410,6 @@ func Test_09_00_04_00_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_01_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.01-term-not-classifier-literal")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2420,9 +2417,6 @@ func Test_09_00_04_01_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_04_02_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.04.02-two-classifiers")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2430,9 +2424,6 @@ func Test_09_00_04_02_LexerListDefinitionGood(t *testing.T) {
 }
 
 func Test_09_00_05_00_LexerListDefinitionGood(t *testing.T) {
-	if os.Getenv("GO_RST_SKIP_NOT_IMPLEMENTED") == "1" {
-		t.SkipNow()
-	}
 	testPath := testutil.TestPathFromName("09.00.05.00-not-literal")
 	test := LoadLexTest(t, testPath)
 	items := lexTest(t, test)
@@ -2459,3 +2450,661 @@ func Test_10_00_00_00_LexerListOptionGood(t *testing.T) {
 	equal(t, test.ExpectItemData, items)
 }
 
+func Test_11_00_00_00_LexerDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.00-note")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_00_01_LexerDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.01-all-admonitions")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_00_02_LexerDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.00.02-note-with-options")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_01_00_LexerDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.01.00-generic-admonition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_01_01_LexerDirectiveAdmonitionGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.01.01-generic-admonition-with-class")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_02_00_LexerDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.00-bad-empty-admonition")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_02_01_LexerDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.01-bad-generic-admonition-without-title")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_02_02_LexerDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.02-bad-unknown-option")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_11_00_02_03_LexerDirectiveAdmonitionBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("11.00.02.03-bad-unknown-directive")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_00_00_LexerDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.00-code-go")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_00_01_LexerDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.01-code-shell-number-lines")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_00_02_LexerDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.02-code-json-yaml")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_00_03_LexerDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.00.03-code-no-language")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_01_00_LexerDirectiveCodeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.01.00-code-role")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_02_00_LexerDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.00-bad-code-no-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_02_01_LexerDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.01-bad-code-unknown-language")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_12_00_02_02_LexerDirectiveCodeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("12.00.02.02-bad-code-number-lines-not-integer")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_00_00_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.00-image")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_00_01_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.01-image-options")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_00_02_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.00.02-image-uri-whitespace")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_01_00_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.00-figure")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_01_01_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.01-figure-no-caption")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_01_02_LexerDirectiveImageGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.01.02-figure-legend-only")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_00_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.00-bad-image-width-unit")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_01_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.01-bad-image-height-percentage")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_02_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.02-bad-image-align")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_03_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.03-bad-figure-align")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_04_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.04-bad-image-scale-negative")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_05_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.05-bad-image-no-uri")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_13_00_02_06_LexerDirectiveImageBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("13.00.02.06-bad-figure-caption")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_00_00_LexerDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.00-contents")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_00_01_LexerDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.01-contents-title-depth-backlinks")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_00_02_LexerDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.02-contents-local")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_00_03_LexerDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.03-contents-no-sections")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_00_04_LexerDirectiveContentsGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.00.04-contents-duplicate-ids")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_01_00_LexerDirectiveContentsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.01.00-bad-contents-depth")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_14_00_01_01_LexerDirectiveContentsBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("14.00.01.01-bad-contents-backlinks")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_15_00_00_00_LexerDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.00-sectnum")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_15_00_00_01_LexerDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.01-sectnum-options")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_15_00_00_02_LexerDirectiveSectnumGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.00.02-sectnum-contents")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_15_00_01_00_LexerDirectiveSectnumBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.01.00-bad-sectnum-start")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_15_00_01_01_LexerDirectiveSectnumBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("15.00.01.01-bad-sectnum-prefix-empty")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_00_00_LexerDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.00-include")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_00_01_LexerDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.01-include-section")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_00_02_LexerDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.02-include-literal-lines")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_00_03_LexerDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.03-include-start-after-end-before")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_00_04_LexerDirectiveIncludeGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.00.04-include-code")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_00_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.00-bad-include-missing-file")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_01_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.01-bad-include-recursion")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_02_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.02-bad-include-indirect-recursion")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_03_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.03-bad-include-text-not-found")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_04_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.04-bad-include-message-source")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_16_00_01_05_LexerDirectiveIncludeBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("16.00.01.05-bad-include-outside-fs")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_00_00_LexerDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.00-raw")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_00_01_LexerDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.01-raw-multiple-formats")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_00_02_LexerDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.02-raw-file")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_00_03_LexerDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.03-raw-role")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_00_04_LexerDirectiveRawGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.00.04-role-generic")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_01_00_LexerDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.00-bad-raw-role-direct-use")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_01_01_LexerDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.01-bad-raw-file-and-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_01_02_LexerDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.02-bad-raw-no-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_17_00_01_03_LexerDirectiveRawBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("17.00.01.03-bad-role-unknown-base")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_00_00_LexerDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.00-math")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_00_01_LexerDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.01-math-multiple-blocks")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_00_02_LexerDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.02-math-directive-line")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_00_03_LexerDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.03-math-role")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_00_04_LexerDirectiveMathGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.00.04-math-greek-and-matrix")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_18_00_01_00_LexerDirectiveMathBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("18.00.01.00-bad-math-no-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_00_00_LexerDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.00-list-table")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_00_01_LexerDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.01-list-table-stub-columns")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_00_02_LexerDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.02-csv-table")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_00_03_LexerDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.03-csv-table-delim-header-rows")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_00_04_LexerDirectiveTableGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.00.04-csv-table-file")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_00_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.00-bad-list-table-ragged-rows")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_01_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.01-bad-list-table-not-two-level")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_02_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.02-bad-list-table-widths")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_03_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.03-bad-csv-table-ragged-rows")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_04_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.04-bad-csv-table-header-rows")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_19_00_01_05_LexerDirectiveTableBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("19.00.01.05-bad-list-table-stub-columns")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_00_00_LexerDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.00-class")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_00_01_LexerDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.01-class-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_00_02_LexerDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.02-class-skips-comments")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_00_03_LexerDirectiveClassGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.00.03-class-last-in-section")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_01_00_LexerDirectiveClassBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.01.00-bad-class-no-element")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_20_00_01_01_LexerDirectiveClassBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("20.00.01.01-bad-class-no-argument")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_00_00_LexerDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.00-meta")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_00_01_LexerDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.01-meta-multiline-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_00_02_LexerDirectiveMetaGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.00.02-title")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_01_00_LexerDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.00-bad-meta-no-content")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_01_01_LexerDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.01-bad-meta-invalid")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_01_02_LexerDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.02-bad-meta-attribute")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_21_00_01_03_LexerDirectiveMetaBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("21.00.01.03-bad-meta-attribute-name")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_22_00_00_00_LexerDirectiveDefaultRoleGood(t *testing.T) {
+	testPath := testutil.TestPathFromName("22.00.00.00-default-role")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
+func Test_22_00_01_00_LexerDirectiveDefaultRoleBad(t *testing.T) {
+	testPath := testutil.TestPathFromName("22.00.01.00-bad-default-role-unknown")
+	test := LoadLexTest(t, testPath)
+	items := lexTest(t, test)
+	equal(t, test.ExpectItemData, items)
+}
+
@@ -1,6 +1,7 @@
 package token
 
 import (
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -37,6 +38,12 @@ func isSection(l *Lexer) bool {
 		return false
 	}
 
+	if strings.TrimSpace(l.currentLine()) == "::" && (l.line == 0 || strings.TrimSpace(l.lines[l.line-1]) == "") {
+		// A line containing only "::" is a literal block marker, it cannot be an overline
+		l.Msg("Found literal block marker")
+		return false
+	}
+
 	if checkLine(l.currentLine()) {
 		l.Msg("Found section adornment")
 		return true
@@ -113,7 +113,8 @@ func lexHyperlinkTargetName(l *Lexer) stateFn {
 				l.emit(HyperlinkTargetName)
 			}
 			break
-		} else if unicode.IsSpace(l.mark) && (lp != EOL && unicode.IsSpace(lp)) {
+		} else if l.index == l.start && unicode.IsSpace(l.mark) && (lp != EOL && unicode.IsSpace(lp)) {
+			// Indentation of a name continued on the next line, white space inside the name is part of the name
 			lexSpace(l)
 		} else if l.mark == EOL && !unicode.IsSpace(lp) {
 			l.emit(HyperlinkTargetName)
@@ -156,7 +157,8 @@ func lexHyperlinkTargetBlock(l *Lexer) stateFn {
 		} else if !inquote && l.mark == EOL {
 			// end of current line
 			l.emit(HyperlinkTargetURI)
-			if lp == EOL {
+			if lp == EOL || !unicode.IsSpace(lp) {
+				// The link block ends at a blank line or a line that is not indented
 				break
 			}
 			// uri continues on next line
@@ -205,7 +207,7 @@ func lexAnonymousHyperlinkTargetBlock(l *Lexer) stateFn {
 		} else if l.mark == EOL {
 			// end of current line
 			l.emit(HyperlinkTargetURI)
-			if lp == EOL {
+			if lp == EOL || !unicode.IsSpace(lp) {
 				break
 			}
 			// uri continues on next line
@@ -3,6 +3,7 @@ package token
 import (
 	"encoding/json"
 	"io/ioutil"
+	"strings"
 	"testing"
 	"unicode/utf8"
 
@@ -15,7 +16,7 @@ var (
 
 func lexTest(t *testing.T, test *testutil.Test) []Item {
 	var items []Item
-	l, err := Lex(test.Path, []byte(test.Data), testutil.LoggerConfig)
+	l, err := Lex(test.Path, []byte(test.Data), DefaultTabWidth, testutil.LoggerConfig)
 	if err != nil {
 		t.Errorf("error from lexer: %s", err)
 		t.Fail()
@@ -33,6 +34,12 @@ func lexTest(t *testing.T, test *testutil.Test) []Item {
 // Test equality between items and expected items from unmarshalled json data, field by field. Returns error in case of
 // error during json unmarshalling, or mismatch between items and the expected output.
 func equal(t *testing.T, expectItemData string, items []Item) {
+	// The locations of the items are only compared if the expected items have them
+	if !strings.Contains(expectItemData, `"column"`) {
+		for k := range items {
+			items[k].Offset, items[k].EndOffset, items[k].Column, items[k].EndLine, items[k].EndColumn = 0, 0, 0, 0, 0
+		}
+	}
 	pJson, _ := json.MarshalIndent(items, "", "  ")
 
 	// Json diff output has a syntax: https://github.com/josephburnett/jd#diff-language
@@ -105,7 +112,7 @@ var lexerTests = []struct {
 
 func TestLexerNew(t *testing.T) {
 	for _, tt := range lexerTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
@@ -151,7 +158,7 @@ var lexerGotoLocationTests = []struct {
 
 func TestLexerGotoLocation(t *testing.T) {
 	for _, tt := range lexerGotoLocationTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
@@ -243,7 +250,7 @@ var lexerBackupTests = []struct {
 
 func TestLexerBackup(t *testing.T) {
 	for _, tt := range lexerBackupTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
@@ -333,7 +340,7 @@ var lexerNextTests = []struct {
 
 func TestLexerNext(t *testing.T) {
 	for _, tt := range lexerNextTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
@@ -413,7 +420,7 @@ var lexerPeekTests = []struct {
 
 func TestLexerPeek(t *testing.T) {
 	for _, tt := range lexerPeekTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
@@ -441,7 +448,7 @@ func TestLexerPeek(t *testing.T) {
 
 func TestLexerIsLastLine(t *testing.T) {
 	input := "==============\nTitle\n=============="
-	lex, err := newLexer("isLastLine test 1", []byte(input), testutil.LoggerConfig)
+	lex, err := newLexer("isLastLine test 1", []byte(input), DefaultTabWidth, testutil.LoggerConfig)
 	if err != nil {
 		t.Errorf("error: %s", err)
 		t.Fail()
@@ -450,7 +457,7 @@ func TestLexerIsLastLine(t *testing.T) {
 	if lex.isLastLine() != false {
 		t.Errorf("Test: %q\n\tGot: isLastLine == %t, Expect: %t", lex.Name, lex.isLastLine(), false)
 	}
-	lex, err = newLexer("isLastLine test 2", []byte(input), testutil.LoggerConfig)
+	lex, err = newLexer("isLastLine test 2", []byte(input), DefaultTabWidth, testutil.LoggerConfig)
 	if err != nil {
 		t.Errorf("error: %s", err)
 		t.Fail()
@@ -459,7 +466,7 @@ func TestLexerIsLastLine(t *testing.T) {
 	if lex.isLastLine() != false {
 		t.Errorf("Test: %q\n\tGot: isLastLine == %t, Expect: %t", lex.Name, lex.isLastLine(), false)
 	}
-	lex, err = newLexer("isLastLine test 3", []byte(input), testutil.LoggerConfig)
+	lex, err = newLexer("isLastLine test 3", []byte(input), DefaultTabWidth, testutil.LoggerConfig)
 	if err != nil {
 		t.Errorf("error: %s", err)
 		t.Fail()
@@ -513,7 +520,7 @@ var peekNextLineTests = []struct {
 
 func TestLexerPeekNextLine(t *testing.T) {
 	for _, tt := range peekNextLineTests {
-		lex, err := newLexer(tt.name, []byte(tt.input), testutil.LoggerConfig)
+		lex, err := newLexer(tt.name, []byte(tt.input), DefaultTabWidth, testutil.LoggerConfig)
 		if err != nil {
 			t.Errorf("error: %s", err)
 			t.Fail()
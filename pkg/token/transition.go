@@ -1,7 +1,23 @@
 package token
 
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// isTransitionMarker returns true if line is a transition marker, four or more repeated section adornment characters.
+func isTransitionMarker(line string) bool {
+	line = strings.TrimRight(line, " \t")
+	first, _ := utf8.DecodeRuneInString(line)
+	if !isSectionAdornment(first) || utf8.RuneCountInString(line) < 4 {
+		return false
+	}
+	return strings.Trim(line, string(first)) == ""
+}
+
 func isTransition(l *Lexer) bool {
-	if r := l.peek(4); !isSectionAdornment(l.mark) || !isSectionAdornment(r) {
+	if !isTransitionMarker(l.currentLine()) {
+		// Parse Test 08.00.04.00 :: "(LCD)" is not a transition
 		l.Msg("Transition not found")
 		return false
 	}
@@ -0,0 +1,26 @@
+package token
+
+import (
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+// ColumnWidth returns the number of columns text occupies when displayed in a fixed width font. Wide and fullwidth East
+// Asian characters, which include CJK ideographs and most emoji, occupy two columns. Combining characters occupy no columns.
+// All other characters occupy one column. This is the column_width function of docutils, it is used to check that section
+// adornments line up with their title.
+func ColumnWidth(text string) int {
+	var n int
+	for i, r := range text {
+		if norm.NFD.PropertiesString(text[i:]).CCC() != 0 {
+			continue
+		}
+		switch width.LookupRune(r).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
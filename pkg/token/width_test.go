@@ -0,0 +1,24 @@
+package token
+
+import "testing"
+
+func TestColumnWidth(t *testing.T) {
+	tests := []struct {
+		input  string
+		expect int
+	}{
+		{"Title", 5},
+		{"中文标题", 8},
+		{"日本語のタイトル", 16},
+		{"ｶﾀｶﾅ", 4}, // Halfwidth katakana
+		{"ＡＢＣ", 6},  // Fullwidth latin
+		{"Emoji 🎉", 8},
+		{"a\u0300 with combining", 16},
+		{"Größe", 5},
+	}
+	for _, tt := range tests {
+		if got := ColumnWidth(tt.input); got != tt.expect {
+			t.Errorf("ColumnWidth(%q) = %d, expect %d", tt.input, got, tt.expect)
+		}
+	}
+}